@@ -70,6 +70,8 @@ func (adapt *Adapter) FromJobProto(spec *pb.JobSpecification) (models.JobSpec, e
 	retryCount := 0
 	retryExponentialBackoff := false
 	var notifiers []models.JobSpecNotifier
+	var resources models.JobSpecResources
+	var execution models.JobSpecExecution
 	if spec.Behavior != nil {
 		if spec.Behavior.Retry != nil {
 			retryCount = int(spec.Behavior.Retry.Count)
@@ -86,6 +88,29 @@ func (adapt *Adapter) FromJobProto(spec *pb.JobSpecification) (models.JobSpec, e
 				Channels: notify.Channels,
 			})
 		}
+
+		if spec.Behavior.Resources != nil {
+			if request := spec.Behavior.Resources.Request; request != nil {
+				resources.Request = models.JobSpecResourceConfig{
+					CPU:    request.Cpu,
+					Memory: request.Memory,
+				}
+			}
+			if limit := spec.Behavior.Resources.Limit; limit != nil {
+				resources.Limit = models.JobSpecResourceConfig{
+					CPU:    limit.Cpu,
+					Memory: limit.Memory,
+				}
+			}
+		}
+
+		if spec.Behavior.Execution != nil {
+			execution.Pool = spec.Behavior.Execution.Pool
+			execution.Queue = spec.Behavior.Execution.Queue
+			if spec.Behavior.Execution.Timeout != nil && spec.Behavior.Execution.Timeout.IsValid() {
+				execution.Timeout = spec.Behavior.Execution.Timeout.AsDuration()
+			}
+		}
 	}
 	return models.JobSpec{
 		Version:     int(spec.Version),
@@ -107,7 +132,9 @@ func (adapt *Adapter) FromJobProto(spec *pb.JobSpecification) (models.JobSpec, e
 				Delay:              retryDelay,
 				ExponentialBackoff: retryExponentialBackoff,
 			},
-			Notify: notifiers,
+			Notify:    notifiers,
+			Resources: resources,
+			Execution: execution,
 		},
 		Task: models.JobSpecTask{
 			Unit:   execUnit,
@@ -183,6 +210,21 @@ func (adapt *Adapter) ToJobProto(spec models.JobSpec) (*pb.JobSpecification, err
 				ExponentialBackoff: spec.Behavior.Retry.ExponentialBackoff,
 			},
 			Notify: notifyProto,
+			Resources: &pb.JobSpecification_Behavior_Resources{
+				Request: &pb.JobSpecification_Behavior_Resources_ResourceConfig{
+					Cpu:    spec.Behavior.Resources.Request.CPU,
+					Memory: spec.Behavior.Resources.Request.Memory,
+				},
+				Limit: &pb.JobSpecification_Behavior_Resources_ResourceConfig{
+					Cpu:    spec.Behavior.Resources.Limit.CPU,
+					Memory: spec.Behavior.Resources.Limit.Memory,
+				},
+			},
+			Execution: &pb.JobSpecification_Behavior_Execution{
+				Pool:    spec.Behavior.Execution.Pool,
+				Queue:   spec.Behavior.Execution.Queue,
+				Timeout: ptypes.DurationProto(spec.Behavior.Execution.Timeout),
+			},
 		},
 	}
 	if spec.Schedule.EndDate != nil {
@@ -84,6 +84,20 @@ func TestAdapter(t *testing.T) {
 						Channels: []string{"slack://@devs"},
 					},
 				},
+				Resources: models.JobSpecResources{
+					Request: models.JobSpecResourceConfig{
+						CPU:    "250m",
+						Memory: "128Mi",
+					},
+					Limit: models.JobSpecResourceConfig{
+						Memory: "1Gi",
+					},
+				},
+				Execution: models.JobSpecExecution{
+					Pool:    "bigquery",
+					Queue:   "heavy",
+					Timeout: time.Hour * 2,
+				},
 			},
 			Task: models.JobSpecTask{
 				Unit: &models.Plugin{Base: execUnit1},
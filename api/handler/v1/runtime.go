@@ -20,11 +20,13 @@ import (
 	"github.com/odpf/optimus/datastore"
 	"github.com/odpf/optimus/job"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/run"
 	"github.com/odpf/optimus/store"
 	"github.com/odpf/salt/log"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -38,8 +40,16 @@ var (
 		Name: "runtime_deploy_resourcespec",
 		Help: "Number of resources requested for deployment by runtime",
 	})
+
+	// instanceLogsPollInterval is how often logs are checked for more output
+	// while following an instance
+	instanceLogsPollInterval = time.Second * 2
 )
 
+// instanceStatusEventScheduler is the only scheduler whose instances report
+// their completion through job events, it has no api of its own to ask for it
+const instanceStatusEventScheduler = "kubernetes"
+
 type ProjectRepoFactory interface {
 	New() store.ProjectRepository
 }
@@ -540,6 +550,55 @@ func (sv *RuntimeServiceServer) JobStatus(ctx context.Context, req *pb.JobStatus
 	}, nil
 }
 
+// JobRunDetails returns execution details of every task, hook and sensor of
+// a job run, only supported for schedulers which can report them
+func (sv *RuntimeServiceServer) JobRunDetails(ctx context.Context, req *pb.JobRunDetailsRequest) (*pb.JobRunDetailsResponse, error) {
+	detailsScheduler, ok := sv.scheduler.(models.JobRunDetailsScheduler)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "run details are not supported by %s scheduler", sv.scheduler.GetName())
+	}
+	if req.GetScheduledAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled time of the run is required")
+	}
+
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	if _, _, err := sv.jobSvc.GetByNameForProject(ctx, req.GetJobName(), projSpec); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	runDetails, err := detailsScheduler.GetJobRunDetails(ctx, projSpec, req.GetJobName(), req.GetScheduledAt().AsTime())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to fetch details of run of job %s", err.Error(), req.GetJobName())
+	}
+
+	var instances []*pb.JobRunInstanceDetails
+	for _, instance := range runDetails.Instances {
+		instanceProto := &pb.JobRunInstanceDetails{
+			Name:      instance.Name,
+			Type:      instance.Type.String(),
+			State:     instance.State.String(),
+			TryNumber: int32(instance.TryNumber),
+			Duration:  durationpb.New(instance.Duration),
+		}
+		if !instance.StartedAt.IsZero() {
+			instanceProto.StartedAt = timestamppb.New(instance.StartedAt)
+		}
+		if !instance.EndedAt.IsZero() {
+			instanceProto.EndedAt = timestamppb.New(instance.EndedAt)
+		}
+		instances = append(instances, instanceProto)
+	}
+	return &pb.JobRunDetailsResponse{
+		ScheduledAt: timestamppb.New(runDetails.ScheduledAt),
+		State:       runDetails.State.String(),
+		Instances:   instances,
+	}, nil
+}
+
 func (sv *RuntimeServiceServer) RegisterJobEvent(ctx context.Context, req *pb.RegisterJobEventRequest) (*pb.RegisterJobEventResponse, error) {
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
@@ -567,16 +626,56 @@ func (sv *RuntimeServiceServer) RegisterJobEvent(ctx context.Context, req *pb.Re
 	if req.GetEvent().Value != nil {
 		eventValues = req.GetEvent().Value.GetFields()
 	}
-	if err := sv.jobEventSvc.Register(ctx, namespaceSpec, jobSpec, models.JobEvent{
+	event := models.JobEvent{
 		Type:  models.JobEventType(strings.ToLower(req.GetEvent().Type.String())),
 		Value: eventValues,
-	}); err != nil {
+	}
+	if err := sv.updateInstanceStatus(ctx, namespaceSpec, jobSpec, event); err != nil {
+		return nil, err
+	}
+	if err := sv.jobEventSvc.Register(ctx, namespaceSpec, jobSpec, event); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to register event: \n%s", err.Error())
 	}
 
 	return &pb.RegisterJobEventResponse{}, nil
 }
 
+// updateInstanceStatus sets status of the instance a success or failure
+// event is reported for when the kubernetes scheduler is in use, it reports
+// completion of instances this way. Other events are ignored
+func (sv *RuntimeServiceServer) updateInstanceStatus(ctx context.Context, namespaceSpec models.NamespaceSpec,
+	jobSpec models.JobSpec, event models.JobEvent) error {
+	if sv.scheduler == nil || sv.scheduler.GetName() != instanceStatusEventScheduler {
+		return nil
+	}
+	var instanceStatus models.JobRunState
+	switch event.Type {
+	case models.JobEventTypeSuccess:
+		instanceStatus = models.RunStateSuccess
+	case models.JobEventTypeFailure:
+		instanceStatus = models.RunStateFailed
+	default:
+		return nil
+	}
+	instanceName := event.Value[models.JobEventKeyInstanceName].GetStringValue()
+	if instanceName == "" {
+		return nil
+	}
+
+	instanceType, err := models.InstanceType("").New(event.Value[models.JobEventKeyInstanceType].GetStringValue())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: invalid instance type of event", err.Error())
+	}
+	scheduledAt, err := time.Parse(models.InstanceScheduledAtTimeLayout, event.Value[models.JobEventKeyScheduledAt].GetStringValue())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: invalid scheduled time of event", err.Error())
+	}
+	if err := sv.runSvc.UpdateInstanceStatus(ctx, namespaceSpec, jobSpec, scheduledAt, instanceType, instanceName, instanceStatus); err != nil {
+		return status.Errorf(codes.Internal, "%s: failed to update status of %s %s", err.Error(), instanceType, instanceName)
+	}
+	return nil
+}
+
 func (sv *RuntimeServiceServer) GetWindow(ctx context.Context, req *pb.GetWindowRequest) (*pb.GetWindowResponse, error) {
 	scheduledTime := req.ScheduledAt.AsTime()
 	err := req.ScheduledAt.CheckValid()
@@ -618,19 +717,63 @@ func (sv *RuntimeServiceServer) RegisterSecret(ctx context.Context, req *pb.Regi
 		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
 	}
 
-	secretRepo := sv.secretRepoFactory.New(projSpec)
-	if err := secretRepo.Save(ctx, models.ProjectSecretItem{
+	secretItem := models.ProjectSecretItem{
 		Name:  req.GetSecretName(),
 		Value: string(base64Decoded),
-	}); err != nil {
+	}
+	secretRepo := sv.secretRepoFactory.New(projSpec)
+	if err := secretRepo.Save(ctx, secretItem); err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to save secret %s", err.Error(), req.GetSecretName())
 	}
 
+	// keep the copy of secret held by scheduler up to date, the secret is
+	// already saved so failing to sync is only a warning, bootstrap of the
+	// project syncs it again on server start
+	if syncingScheduler, ok := sv.scheduler.(models.SecretSyncingScheduler); ok {
+		if err := syncingScheduler.SyncSecret(ctx, projSpec, secretItem); err != nil {
+			sv.l.Warn("failed to sync secret with scheduler", "project", projSpec.Name, "secret", req.GetSecretName(), "error", err.Error())
+			return &pb.RegisterSecretResponse{
+				Success: true,
+				Message: fmt.Sprintf("secret %s saved but failed to sync with scheduler, it will be retried on server start: %s", req.GetSecretName(), err.Error()),
+			}, nil
+		}
+	}
+
 	return &pb.RegisterSecretResponse{
 		Success: true,
 	}, nil
 }
 
+// RegisterProjectJobTemplate validates the template by compiling a sample of
+// project jobs with it before saving, an empty template resets the project
+// back to the default template of scheduler
+func (sv *RuntimeServiceServer) RegisterProjectJobTemplate(ctx context.Context, req *pb.RegisterProjectJobTemplateRequest) (*pb.RegisterProjectJobTemplateResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	template := []byte(req.GetTemplate())
+	if _, ok := sv.scheduler.(models.CustomTemplateScheduler); !ok && len(template) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "scheduler %s doesn't support custom job templates", sv.scheduler.GetName())
+	}
+	if len(template) > 0 {
+		if err := sv.jobSvc.CheckJobTemplate(ctx, projSpec, template); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: invalid job template for project %s", err.Error(), req.GetProjectName())
+		}
+	}
+
+	if err := projectRepo.SetJobTemplate(ctx, projSpec.Name, template); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to save job template for project %s", err.Error(), req.GetProjectName())
+	}
+
+	return &pb.RegisterProjectJobTemplateResponse{
+		Success: true,
+		Message: "saved successfully",
+	}, nil
+}
+
 func (sv *RuntimeServiceServer) CreateResource(ctx context.Context, req *pb.CreateResourceRequest) (*pb.CreateResourceResponse, error) {
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(ctx, req.GetProjectName())
@@ -787,10 +930,12 @@ func (sv *RuntimeServiceServer) ListResourceSpecification(ctx context.Context, r
 }
 
 func (sv *RuntimeServiceServer) ReplayDryRun(ctx context.Context, req *pb.ReplayDryRunRequest) (*pb.ReplayDryRunResponse, error) {
-	replayRequest, err := sv.parseReplayRequest(ctx, req.ProjectName, req.Namespace, req.JobName, req.StartDate, req.EndDate, false)
+	replayRequest, err := sv.parseReplayRequest(ctx, req.ProjectName, req.Namespace, req.JobName, req.StartDate, req.EndDate, false, req.Filter)
 	if err != nil {
 		return nil, err
 	}
+	replayRequest.FailedRunsOnly = req.FailedRunsOnly
+	replayRequest.FailedRunsDownstream = req.FailedRunsDownstream
 
 	rootNode, err := sv.jobSvc.ReplayDryRun(ctx, replayRequest)
 	if err != nil {
@@ -808,10 +953,12 @@ func (sv *RuntimeServiceServer) ReplayDryRun(ctx context.Context, req *pb.Replay
 }
 
 func (sv *RuntimeServiceServer) Replay(ctx context.Context, req *pb.ReplayRequest) (*pb.ReplayResponse, error) {
-	replayWorkerRequest, err := sv.parseReplayRequest(ctx, req.ProjectName, req.Namespace, req.JobName, req.StartDate, req.EndDate, req.Force)
+	replayWorkerRequest, err := sv.parseReplayRequest(ctx, req.ProjectName, req.Namespace, req.JobName, req.StartDate, req.EndDate, req.Force, req.Filter)
 	if err != nil {
 		return nil, err
 	}
+	replayWorkerRequest.FailedRunsOnly = req.FailedRunsOnly
+	replayWorkerRequest.FailedRunsDownstream = req.FailedRunsDownstream
 
 	replayUUID, err := sv.jobSvc.Replay(ctx, replayWorkerRequest)
 	if err != nil {
@@ -819,6 +966,8 @@ func (sv *RuntimeServiceServer) Replay(ctx context.Context, req *pb.ReplayReques
 			return nil, status.Errorf(codes.Unavailable, "error while processing replay: %v", err)
 		} else if errors.Is(err, job.ErrConflictedJobRun) {
 			return nil, status.Errorf(codes.FailedPrecondition, "error while validating replay: %v", err)
+		} else if errors.Is(err, job.ErrReplayNoFailedRuns) {
+			return nil, status.Errorf(codes.FailedPrecondition, "error while processing replay: %v", err)
 		}
 		return nil, status.Errorf(codes.Internal, "error while processing replay: %v", err)
 	}
@@ -896,8 +1045,40 @@ func (sv *RuntimeServiceServer) ListReplays(ctx context.Context, req *pb.ListRep
 	}, nil
 }
 
+// CancelReplay stops a replay which is still clearing or running job runs
+func (sv *RuntimeServiceServer) CancelReplay(ctx context.Context, req *pb.CancelReplayRequest) (*pb.CancelReplayResponse, error) {
+	projSpec, err := sv.getProjectSpec(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, err
+	}
+
+	replayID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error while parsing replay ID: %v", err)
+	}
+
+	replayRequest := models.ReplayRequest{
+		ID:      replayID,
+		Project: projSpec,
+	}
+	if err := sv.jobSvc.CancelReplay(ctx, replayRequest, req.GetFailRuns()); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: replay %s not found", err.Error(), req.GetId())
+		}
+		if errors.Is(err, job.ErrReplayNotCancellable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: failed to cancel replay %s", err.Error(), req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to cancel replay %s", err.Error(), req.GetId())
+	}
+
+	return &pb.CancelReplayResponse{
+		Success: true,
+		Message: "cancelled successfully",
+	}, nil
+}
+
 func (sv *RuntimeServiceServer) parseReplayRequest(ctx context.Context, projectName string, namespace string, jobName string, startDate string,
-	endDate string, forceFlag bool) (models.ReplayRequest, error) {
+	endDate string, forceFlag bool, filter *pb.ReplayFilter) (models.ReplayRequest, error) {
 	projSpec, err := sv.getProjectSpec(ctx, projectName)
 	if err != nil {
 		return models.ReplayRequest{}, err
@@ -922,16 +1103,55 @@ func (sv *RuntimeServiceServer) parseReplayRequest(ctx context.Context, projectN
 	if windowEnd.Before(windowStart) {
 		return models.ReplayRequest{}, status.Errorf(codes.InvalidArgument, "replay end date cannot be before start date")
 	}
+	replayFilter, err := sv.parseReplayFilter(ctx, projSpec, filter)
+	if err != nil {
+		return models.ReplayRequest{}, err
+	}
 	replayRequest := models.ReplayRequest{
 		Job:     jobSpec,
 		Start:   windowStart,
 		End:     windowEnd,
 		Project: projSpec,
 		Force:   forceFlag,
+		Filter:  replayFilter,
 	}
 	return replayRequest, nil
 }
 
+func (sv *RuntimeServiceServer) parseReplayFilter(ctx context.Context, projSpec models.ProjectSpec, filter *pb.ReplayFilter) (models.ReplayFilter, error) {
+	if filter == nil {
+		return models.ReplayFilter{}, nil
+	}
+	if filter.GetMaxDepth() < 0 {
+		return models.ReplayFilter{}, status.Errorf(codes.InvalidArgument, "replay filter max depth cannot be negative")
+	}
+
+	replayFilter := models.ReplayFilter{
+		RootOnly:      filter.GetRootOnly(),
+		MaxDepth:      int(filter.GetMaxDepth()),
+		IncludeJobs:   filter.GetIncludeJobs(),
+		ExcludeJobs:   filter.GetExcludeJobs(),
+		IncludeLabels: filter.GetIncludeLabels(),
+		ExcludeLabels: filter.GetExcludeLabels(),
+	}
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	for _, name := range filter.GetIncludeNamespaces() {
+		namespaceSpec, err := namespaceRepo.GetByName(ctx, name)
+		if err != nil {
+			return models.ReplayFilter{}, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), name)
+		}
+		replayFilter.IncludeNamespaces = append(replayFilter.IncludeNamespaces, namespaceSpec)
+	}
+	for _, name := range filter.GetExcludeNamespaces() {
+		namespaceSpec, err := namespaceRepo.GetByName(ctx, name)
+		if err != nil {
+			return models.ReplayFilter{}, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), name)
+		}
+		replayFilter.ExcludeNamespaces = append(replayFilter.ExcludeNamespaces, namespaceSpec)
+	}
+	return replayFilter, nil
+}
+
 func (sv *RuntimeServiceServer) getProjectSpec(ctx context.Context, projectName string) (models.ProjectSpec, error) {
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(ctx, projectName)
@@ -1110,6 +1330,210 @@ func (sv *RuntimeServiceServer) RunJob(ctx context.Context, req *pb.RunJobReques
 	return &pb.RunJobResponse{}, nil
 }
 
+func (sv *RuntimeServiceServer) CancelJobRun(ctx context.Context, req *pb.CancelJobRunRequest) (*pb.CancelJobRunResponse, error) {
+	if req.GetScheduledAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled time of the run is required")
+	}
+
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	jobSpec, err := sv.jobSvc.GetByName(ctx, req.GetJobName(), namespaceSpec)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	jobRun, err := sv.runSvc.Cancel(ctx, namespaceSpec, jobSpec, req.GetScheduledAt().AsTime())
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: run of job %s not found", err.Error(), req.GetJobName())
+		}
+		if errors.Is(err, run.ErrRunNotCancellable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: failed to cancel run of job %s", err.Error(), req.GetJobName())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to cancel run of job %s", err.Error(), req.GetJobName())
+	}
+
+	return &pb.CancelJobRunResponse{
+		State: jobRun.Status.String(),
+	}, nil
+}
+
+// PauseJob stops scheduling new runs of a job, runs under execution are not affected
+func (sv *RuntimeServiceServer) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.PauseJobResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	jobSpec, err := sv.jobSvc.GetByName(ctx, req.GetJobName(), namespaceSpec)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	if err := sv.jobSvc.Pause(ctx, namespaceSpec, jobSpec); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to pause job %s", err.Error(), req.GetJobName())
+	}
+
+	return &pb.PauseJobResponse{
+		Success: true,
+	}, nil
+}
+
+// ResumeJob starts scheduling runs of a paused job again
+func (sv *RuntimeServiceServer) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*pb.ResumeJobResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	jobSpec, err := sv.jobSvc.GetByName(ctx, req.GetJobName(), namespaceSpec)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	if err := sv.jobSvc.Resume(ctx, namespaceSpec, jobSpec); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to resume job %s", err.Error(), req.GetJobName())
+	}
+
+	return &pb.ResumeJobResponse{
+		Success: true,
+	}, nil
+}
+
+// TriggerJobRun creates an adhoc run of the job on batch scheduler
+func (sv *RuntimeServiceServer) TriggerJobRun(ctx context.Context, req *pb.TriggerJobRunRequest) (*pb.TriggerJobRunResponse, error) {
+	if req.GetScheduledAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled time of the run is required")
+	}
+
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	jobSpec, err := sv.jobSvc.GetByName(ctx, req.GetJobName(), namespaceSpec)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	if err := sv.jobSvc.TriggerRun(ctx, namespaceSpec, jobSpec, req.GetScheduledAt().AsTime(), req.GetConfig()); err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to trigger run of job %s", err.Error(), req.GetJobName())
+	}
+
+	return &pb.TriggerJobRunResponse{
+		Success: true,
+	}, nil
+}
+
+// CheckJobDrift reports jobs of a namespace which are out of sync between
+// optimus and scheduler, repairing them if requested
+func (sv *RuntimeServiceServer) CheckJobDrift(ctx context.Context, req *pb.CheckJobDriftRequest) (*pb.CheckJobDriftResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	drift, err := sv.jobSvc.GetDrift(ctx, namespaceSpec, req.GetRepair())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to check drift of namespace %s", err.Error(), req.GetNamespace())
+	}
+
+	return &pb.CheckJobDriftResponse{
+		OrphanedJobs: drift.Orphaned,
+		MissingJobs:  drift.Missing,
+		ModifiedJobs: drift.Modified,
+		Repaired:     drift.Repaired,
+	}, nil
+}
+
+// GetInstanceLogs streams output of the latest attempt of an instance, if
+// asked to follow it keeps streaming new output till the instance finishes
+func (sv *RuntimeServiceServer) GetInstanceLogs(req *pb.GetInstanceLogsRequest, respStream pb.RuntimeService_GetInstanceLogsServer) error {
+	if req.GetScheduledAt() == nil {
+		return status.Errorf(codes.InvalidArgument, "scheduled time of the run is required")
+	}
+
+	ctx := respStream.Context()
+	projSpec, err := sv.projectRepoFactory.New().GetByName(ctx, req.GetProjectName())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(ctx, req.GetNamespace())
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	jobSpec, err := sv.jobSvc.GetByName(ctx, req.GetJobName(), namespaceSpec)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+	}
+
+	sentAttempt, sentSize := -1, 0
+	for {
+		instance, logs, err := sv.runSvc.GetLogs(ctx, namespaceSpec, jobSpec, req.GetScheduledAt().AsTime(), req.GetInstanceName())
+		if err != nil {
+			if errors.Is(err, store.ErrResourceNotFound) {
+				return status.Errorf(codes.NotFound, "%s: logs of job %s not found", err.Error(), req.GetJobName())
+			}
+			return status.Errorf(codes.Internal, "%s: failed to fetch logs of job %s", err.Error(), req.GetJobName())
+		}
+
+		// a retry starts streaming from the beginning of its own output
+		if instance.Attempt != sentAttempt {
+			sentAttempt, sentSize = instance.Attempt, 0
+		}
+		if len(logs) > sentSize {
+			if err := respStream.Send(&pb.GetInstanceLogsResponse{
+				InstanceName: instance.Name,
+				Attempt:      int32(instance.Attempt),
+				Logs:         logs[sentSize:],
+			}); err != nil {
+				return err
+			}
+			sentSize = len(logs)
+		}
+
+		// logs are complete once instance has finished
+		if !req.GetFollow() || instance.Status == models.RunStateSuccess || instance.Status == models.RunStateFailed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(instanceLogsPollInterval):
+		}
+	}
+}
+
 func NewRuntimeServiceServer(
 	l log.Logger,
 	version string,
@@ -1161,10 +1585,20 @@ func (obs *jobSyncObserver) Notify(e progress.Event) {
 			resp.Success = false
 			resp.Message = evt.Err.Error()
 		}
+		if evt.Skipped {
+			resp.Message = evt.String()
+		}
 
 		if err := obs.stream.Send(resp); err != nil {
 			obs.log.Error("failed to send deploy spec ack", "evt", evt.String(), "error", err)
 		}
+	case *models.EventJobUploadSkipped:
+		resp := &pb.DeployJobSpecificationResponse{
+			Message: evt.String(),
+		}
+		if err := obs.stream.Send(resp); err != nil {
+			obs.log.Error("failed to send skipped upload notification", "evt", evt.String(), "error", err)
+		}
 	case *models.EventJobRemoteDelete:
 		resp := &pb.DeployJobSpecificationResponse{
 			JobName: evt.Name,
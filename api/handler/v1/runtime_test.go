@@ -785,6 +785,213 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Nil(t, resp)
 			assert.Equal(t, "rpc error: code = Internal desc = random error: failed to save secret hello", err.Error())
 		})
+		t.Run("should save secret with a warning if syncing secret with scheduler fails", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				Name: "a-data-project",
+				Config: map[string]string{
+					"BUCKET": "gs://some_folder",
+				},
+				Secret: models.ProjectSecrets{
+					{
+						Name:  "hello",
+						Value: "old-world",
+					},
+				},
+			}
+			sec := models.ProjectSecretItem{
+				Name:  "hello",
+				Value: "world",
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			projectSecretRepository := new(mock.ProjectSecretRepository)
+			projectSecretRepository.On("Save", ctx, sec).Return(nil)
+			defer projectSecretRepository.AssertExpectations(t)
+
+			projectSecretRepoFactory := new(mock.ProjectSecretRepoFactory)
+			projectSecretRepoFactory.On("New", projectSpec).Return(projectSecretRepository)
+			defer projectSecretRepoFactory.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			scheduler.On("SyncSecret", ctx, projectSpec, sec).Return(errors.New("random error"))
+			defer scheduler.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				nil, nil, nil,
+				projectRepoFactory,
+				nil,
+				projectSecretRepoFactory,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				scheduler,
+			)
+
+			resp, err := runtimeServiceServer.RegisterSecret(ctx, &pb.RegisterSecretRequest{
+				ProjectName: projectSpec.Name,
+				SecretName:  "hello",
+				Value:       base64.StdEncoding.EncodeToString([]byte("world")),
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.GetSuccess())
+			assert.Equal(t, "secret hello saved but failed to sync with scheduler, it will be retried on server start: random error", resp.GetMessage())
+		})
+	})
+
+	t.Run("RegisterProjectJobTemplate", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			Name: "a-data-project",
+			Config: map[string]string{
+				"BUCKET": "gs://some_folder",
+			},
+		}
+		template := "# custom template"
+
+		t.Run("should save job template after verifying it with project jobs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("SetJobTemplate", ctx, projectSpec.Name, []byte(template)).Return(nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("CheckJobTemplate", ctx, projectSpec, []byte(template)).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				new(mock.Scheduler),
+			)
+
+			resp, err := runtimeServiceServer.RegisterProjectJobTemplate(ctx, &pb.RegisterProjectJobTemplateRequest{
+				ProjectName: projectSpec.Name,
+				Template:    template,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.RegisterProjectJobTemplateResponse{
+				Success: true,
+				Message: "saved successfully",
+			}, resp)
+		})
+		t.Run("should not save job template if it fails to compile project jobs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("CheckJobTemplate", ctx, projectSpec, []byte(template)).Return(errors.New("random error"))
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				new(mock.Scheduler),
+			)
+
+			resp, err := runtimeServiceServer.RegisterProjectJobTemplate(ctx, &pb.RegisterProjectJobTemplateRequest{
+				ProjectName: projectSpec.Name,
+				Template:    template,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, "rpc error: code = InvalidArgument desc = random error: invalid job template for project a-data-project", err.Error())
+		})
+		t.Run("should reset job template without verification when template is empty", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			projectRepository.On("SetJobTemplate", ctx, projectSpec.Name, []byte{}).Return(nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.RegisterProjectJobTemplate(ctx, &pb.RegisterProjectJobTemplateRequest{
+				ProjectName: projectSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+		})
+		t.Run("should not save job template if scheduler doesn't support custom templates", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			// only exposes methods every scheduler has
+			scheduler := struct {
+				models.SchedulerUnit
+			}{new(mock.Scheduler)}
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"someVersion1.0",
+				new(mock.JobService), nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				scheduler,
+			)
+
+			resp, err := runtimeServiceServer.RegisterProjectJobTemplate(ctx, &pb.RegisterProjectJobTemplateRequest{
+				ProjectName: projectSpec.Name,
+				Template:    template,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, "rpc error: code = FailedPrecondition desc = scheduler mocked doesn't support custom job templates", err.Error())
+		})
 	})
 
 	t.Run("DeployJobSpecification", func(t *testing.T) {
@@ -1292,8 +1499,745 @@ func TestRuntimeServiceServer(t *testing.T) {
 			projectRepoFactory.On("New").Return(projectRepository)
 			defer projectRepoFactory.AssertExpectations(t)
 
-			adapter := v1.NewAdapter(nil, nil)
-
+			adapter := v1.NewAdapter(nil, nil)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpecs[0].Name, namespaceSpec).Return(jobSpecs[0], nil)
+			defer jobService.AssertExpectations(t)
+
+			eventValues, _ := structpb.NewStruct(
+				map[string]interface{}{
+					"url": "https://example.io",
+				},
+			)
+			eventSvc := new(mock.EventService)
+			eventSvc.On("Register", ctx, namespaceSpec, jobSpecs[0], models.JobEvent{
+				Type:  models.JobEventTypeFailure,
+				Value: eventValues.GetFields(),
+			}).Return(nil)
+			defer eventSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				Version,
+				jobService, eventSvc, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				adapter,
+				nil,
+				nil,
+				nil,
+			)
+			req := &pb.RegisterJobEventRequest{
+				ProjectName: projectSpec.Name,
+				JobName:     jobSpecs[0].Name,
+				Namespace:   namespaceSpec.Name,
+				Event: &pb.JobEvent{
+					Type:  pb.JobEvent_FAILURE,
+					Value: eventValues,
+				},
+			}
+			_, err := runtimeServiceServer.RegisterJobEvent(context.Background(), req)
+			assert.Nil(t, err)
+		})
+		t.Run("should update status of the instance the event is reported for", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "game_jam",
+				ProjectSpec: projectSpec,
+			}
+			jobSpec := models.JobSpec{
+				Name: "transform-tables",
+			}
+			scheduledAt := time.Date(2021, 11, 1, 2, 0, 0, 0, time.UTC)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			defer jobService.AssertExpectations(t)
+
+			eventValues, _ := structpb.NewStruct(
+				map[string]interface{}{
+					models.JobEventKeyScheduledAt:  "2021-11-01T02:00:00Z",
+					models.JobEventKeyInstanceType: "task",
+					models.JobEventKeyInstanceName: "bq",
+				},
+			)
+			runService := new(mock.RunService)
+			runService.On("UpdateInstanceStatus", ctx, namespaceSpec, jobSpec, scheduledAt, models.InstanceTypeTask, "bq",
+				models.RunStateSuccess).Return(nil)
+			defer runService.AssertExpectations(t)
+
+			eventSvc := new(mock.EventService)
+			eventSvc.On("Register", ctx, namespaceSpec, jobSpec, models.JobEvent{
+				Type:  models.JobEventTypeSuccess,
+				Value: eventValues.GetFields(),
+			}).Return(nil)
+			defer eventSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, eventSvc, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				runService,
+				&kubernetesScheduler{},
+			)
+			_, err := runtimeServiceServer.RegisterJobEvent(ctx, &pb.RegisterJobEventRequest{
+				ProjectName: projectSpec.Name,
+				JobName:     jobSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				Event: &pb.JobEvent{
+					Type:  pb.JobEvent_SUCCESS,
+					Value: eventValues,
+				},
+			})
+			assert.Nil(t, err)
+		})
+		t.Run("should not update status of the instance if scheduler is not kubernetes", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "game_jam",
+				ProjectSpec: projectSpec,
+			}
+			jobSpec := models.JobSpec{
+				Name: "transform-tables",
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			defer jobService.AssertExpectations(t)
+
+			eventValues, _ := structpb.NewStruct(
+				map[string]interface{}{
+					models.JobEventKeyScheduledAt:  "2021-11-01T02:00:00Z",
+					models.JobEventKeyInstanceType: "task",
+					models.JobEventKeyInstanceName: "bq",
+				},
+			)
+			runService := new(mock.RunService)
+			defer runService.AssertNotCalled(t, "UpdateInstanceStatus")
+
+			eventSvc := new(mock.EventService)
+			eventSvc.On("Register", ctx, namespaceSpec, jobSpec, models.JobEvent{
+				Type:  models.JobEventTypeSuccess,
+				Value: eventValues.GetFields(),
+			}).Return(nil)
+			defer eventSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, eventSvc, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				runService,
+				new(mock.Scheduler),
+			)
+			_, err := runtimeServiceServer.RegisterJobEvent(ctx, &pb.RegisterJobEventRequest{
+				ProjectName: projectSpec.Name,
+				JobName:     jobSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				Event: &pb.JobEvent{
+					Type:  pb.JobEvent_SUCCESS,
+					Value: eventValues,
+				},
+			})
+			assert.Nil(t, err)
+		})
+	})
+
+	t.Run("PauseJob", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "transform-tables",
+		}
+
+		t.Run("should pause the job if valid inputs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("Pause", ctx, namespaceSpec, jobSpec).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.PauseJob(ctx, &pb.PauseJobRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+		})
+		t.Run("should fail if scheduler fails to pause the job", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("Pause", ctx, namespaceSpec, jobSpec).Return(errors.New("dag not found"))
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.PauseJob(ctx, &pb.PauseJobRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	})
+	t.Run("ResumeJob", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			ID:     uuid.Must(uuid.NewRandom()),
+			Name:   "transform-tables",
+			Paused: true,
+		}
+
+		t.Run("should resume the job if valid inputs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("Resume", ctx, namespaceSpec, jobSpec).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ResumeJob(ctx, &pb.ResumeJobRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+		})
+	})
+	t.Run("JobRunDetails", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			Name: "transform-tables",
+		}
+		scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+
+		t.Run("should return details of every instance of the run", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByNameForProject", ctx, jobSpec.Name, projectSpec).Return(jobSpec, namespaceSpec, nil)
+			defer jobService.AssertExpectations(t)
+
+			runDetails := models.JobRunDetails{
+				ScheduledAt: scheduledAt,
+				State:       models.RunStateRunning,
+				Instances: []models.InstanceDetails{
+					{
+						Name:      "upstream-table",
+						Type:      models.InstanceTypeSensor,
+						State:     models.RunStateSuccess,
+						TryNumber: 1,
+						StartedAt: scheduledAt.Add(time.Minute),
+						EndedAt:   scheduledAt.Add(time.Minute * 11),
+						Duration:  time.Minute * 10,
+					},
+					{
+						Name:      "bq2bq",
+						Type:      models.InstanceTypeTask,
+						State:     models.RunStateRunning,
+						TryNumber: 2,
+						StartedAt: scheduledAt.Add(time.Minute * 12),
+					},
+				},
+			}
+			scheduler := new(mock.Scheduler)
+			scheduler.On("GetJobRunDetails", ctx, projectSpec, jobSpec.Name, scheduledAt).Return(runDetails, nil)
+			defer scheduler.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				scheduler,
+			)
+			resp, err := runtimeServiceServer.JobRunDetails(ctx, &pb.JobRunDetailsRequest{
+				ProjectName: projectSpec.Name,
+				JobName:     jobSpec.Name,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "running", resp.State)
+			assert.Equal(t, 2, len(resp.Instances))
+			assert.Equal(t, "sensor", resp.Instances[0].Type)
+			assert.Equal(t, time.Minute*10, resp.Instances[0].Duration.AsDuration())
+			assert.Equal(t, scheduledAt.Add(time.Minute*11), resp.Instances[0].EndedAt.AsTime())
+			assert.Equal(t, int32(2), resp.Instances[1].TryNumber)
+			assert.Nil(t, resp.Instances[1].EndedAt)
+		})
+	})
+	t.Run("TriggerJobRun", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "transform-tables",
+		}
+		scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+		runConfig := map[string]string{"BACKFILL": "true"}
+
+		t.Run("should trigger run of the job if valid inputs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("TriggerRun", ctx, namespaceSpec, jobSpec, scheduledAt, runConfig).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+				ScheduledAt: timestamppb.New(scheduledAt),
+				Config:      runConfig,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+		})
+		t.Run("should fail if scheduled time is not provided", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			_, err := runtimeServiceServer.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+	t.Run("CheckJobDrift", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+
+		t.Run("should return drift of the namespace", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetDrift", ctx, namespaceSpec, true).Return(models.JobDrift{
+				Orphaned: []string{"job-orphaned"},
+				Missing:  []string{"job-missing"},
+				Modified: []string{"job-modified"},
+				Repaired: true,
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.CheckJobDrift(ctx, &pb.CheckJobDriftRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				Repair:      true,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.CheckJobDriftResponse{
+				OrphanedJobs: []string{"job-orphaned"},
+				MissingJobs:  []string{"job-missing"},
+				ModifiedJobs: []string{"job-modified"},
+				Repaired:     true,
+			}, resp)
+		})
+	})
+	t.Run("CancelJobRun", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "game_jam",
+			ProjectSpec: projectSpec,
+		}
+		jobSpec := models.JobSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "transform-tables",
+		}
+		scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+
+		t.Run("should cancel the run of job if valid inputs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			defer jobService.AssertExpectations(t)
+
+			runService := new(mock.RunService)
+			runService.On("Cancel", ctx, namespaceSpec, jobSpec, scheduledAt).Return(models.JobRun{
+				Spec:        jobSpec,
+				Status:      models.RunStateCancelling,
+				ScheduledAt: scheduledAt,
+			}, nil)
+			defer runService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				runService,
+				nil,
+			)
+			resp, err := runtimeServiceServer.CancelJobRun(ctx, &pb.CancelJobRunRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateCancelling.String(), resp.State)
+		})
+		t.Run("should fail with failed precondition if run has already finished", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
+			defer jobService.AssertExpectations(t)
+
+			runService := new(mock.RunService)
+			runService.On("Cancel", ctx, namespaceSpec, jobSpec, scheduledAt).Return(models.JobRun{},
+				errors.Wrap(run.ErrRunNotCancellable, "run is in success state"))
+			defer runService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				jobService, nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				runService,
+				nil,
+			)
+			_, err := runtimeServiceServer.CancelJobRun(ctx, &pb.CancelJobRunRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+		t.Run("should fail with invalid argument if scheduled time is missing", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			_, err := runtimeServiceServer.CancelJobRun(ctx, &pb.CancelJobRunRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobSpec.Name,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+
+	t.Run("GetInstanceLogs", func(t *testing.T) {
+		t.Run("should stream logs of the instance", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "game_jam",
+				ProjectSpec: projectSpec,
+			}
+			jobSpec := models.JobSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "transform-tables",
+			}
+			scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
 			namespaceRepository := new(mock.NamespaceRepository)
 			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
 			defer namespaceRepository.AssertExpectations(t)
@@ -1303,47 +2247,68 @@ func TestRuntimeServiceServer(t *testing.T) {
 			defer namespaceRepoFact.AssertExpectations(t)
 
 			jobService := new(mock.JobService)
-			jobService.On("GetByName", ctx, jobSpecs[0].Name, namespaceSpec).Return(jobSpecs[0], nil)
+			jobService.On("GetByName", ctx, jobSpec.Name, namespaceSpec).Return(jobSpec, nil)
 			defer jobService.AssertExpectations(t)
 
-			eventValues, _ := structpb.NewStruct(
-				map[string]interface{}{
-					"url": "https://example.io",
-				},
-			)
-			eventSvc := new(mock.EventService)
-			eventSvc.On("Register", ctx, namespaceSpec, jobSpecs[0], models.JobEvent{
-				Type:  models.JobEventTypeFailure,
-				Value: eventValues.GetFields(),
-			}).Return(nil)
-			defer eventSvc.AssertExpectations(t)
+			runService := new(mock.RunService)
+			runService.On("GetLogs", ctx, namespaceSpec, jobSpec, scheduledAt, "").Return(models.InstanceSpec{
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateRunning,
+				Attempt: 1,
+			}, []byte("executing query\n"), nil)
+			defer runService.AssertExpectations(t)
+
+			respStream := new(mock.RuntimeService_GetInstanceLogsServer)
+			respStream.On("Context").Return(ctx)
+			respStream.On("Send", &pb.GetInstanceLogsResponse{
+				InstanceName: "bq2bq",
+				Attempt:      1,
+				Logs:         []byte("executing query\n"),
+			}).Return(nil).Once()
+			defer respStream.AssertExpectations(t)
 
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				log,
-				Version,
-				jobService, eventSvc, nil,
+				"1.0.0",
+				jobService, nil, nil,
 				projectRepoFactory,
 				namespaceRepoFact,
 				nil,
-				adapter,
 				nil,
 				nil,
+				runService,
 				nil,
 			)
-			req := &pb.RegisterJobEventRequest{
+			err := runtimeServiceServer.GetInstanceLogs(&pb.GetInstanceLogsRequest{
 				ProjectName: projectSpec.Name,
-				JobName:     jobSpecs[0].Name,
 				Namespace:   namespaceSpec.Name,
-				Event: &pb.JobEvent{
-					Type:  pb.JobEvent_FAILURE,
-					Value: eventValues,
-				},
-			}
-			_, err := runtimeServiceServer.RegisterJobEvent(context.Background(), req)
+				JobName:     jobSpec.Name,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			}, respStream)
 			assert.Nil(t, err)
 		})
+		t.Run("should fail with invalid argument if scheduled time is missing", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"1.0.0",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			err := runtimeServiceServer.GetInstanceLogs(&pb.GetInstanceLogsRequest{
+				ProjectName: "a-data-project",
+				Namespace:   "game_jam",
+				JobName:     "transform-tables",
+			}, new(mock.RuntimeService_GetInstanceLogsServer))
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
 	})
-
 	t.Run("GetWindow", func(t *testing.T) {
 		t.Run("should return the correct window date range", func(t *testing.T) {
 			Version := "1.0.1"
@@ -1703,6 +2668,80 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Equal(t, expectedReplayResponse.Dependents, replayResponse.Response.Dependents)
 			assert.Equal(t, expectedReplayResponse.Runs, replayResponse.Response.Runs)
 		})
+		t.Run("should do replay dry run with filter", func(t *testing.T) {
+			startDate := time.Date(2020, 11, 25, 0, 0, 0, 0, time.UTC)
+			reportingNamespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "reporting",
+				ProjectSpec: projectSpec,
+			}
+			replayWorkerRequest := models.ReplayRequest{
+				Job:     jobSpec,
+				Start:   startDate,
+				End:     startDate,
+				Project: projectSpec,
+				Filter: models.ReplayFilter{
+					MaxDepth:          2,
+					ExcludeJobs:       []string{"legacy-job"},
+					ExcludeNamespaces: []models.NamespaceSpec{reportingNamespaceSpec},
+					IncludeLabels:     map[string]string{"team": "data"},
+				},
+			}
+			dagNode := tree.NewTreeNode(jobSpec)
+			dagNode.Runs.Add(time.Date(2020, 11, 25, 2, 0, 0, 0, time.UTC))
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobName, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("ReplayDryRun", ctx, replayWorkerRequest).Return(dagNode, nil)
+			defer jobService.AssertExpectations(t)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepository.On("GetByName", ctx, reportingNamespaceSpec.Name).Return(reportingNamespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+			adapter := v1.NewAdapter(nil, nil)
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				jobService, nil,
+				nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				adapter,
+				nil,
+				nil,
+				nil,
+			)
+			replayRequest := pb.ReplayDryRunRequest{
+				ProjectName: projectName,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobName,
+				StartDate:   startDate.Format(timeLayout),
+				Filter: &pb.ReplayFilter{
+					MaxDepth:          2,
+					ExcludeJobs:       []string{"legacy-job"},
+					ExcludeNamespaces: []string{reportingNamespaceSpec.Name},
+					IncludeLabels:     map[string]string{"team": "data"},
+				},
+			}
+			replayResponse, err := runtimeServiceServer.ReplayDryRun(ctx, &replayRequest)
+			assert.Nil(t, err)
+			assert.Equal(t, true, replayResponse.Success)
+			assert.Equal(t, jobName, replayResponse.Response.JobName)
+		})
 		t.Run("should failed when replay request is invalid", func(t *testing.T) {
 			startDate := time.Date(2020, 11, 25, 0, 0, 0, 0, time.UTC)
 			endDate := time.Date(2020, 11, 24, 0, 0, 0, 0, time.UTC)
@@ -2206,6 +3245,67 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Equal(t, codes.Unavailable, status.Code(err))
 			assert.Nil(t, replayResponse)
 		})
+		t.Run("should fail with failed precondition when no failed run is found to replay", func(t *testing.T) {
+			replayWorkerRequest := models.ReplayRequest{
+				Job:     jobSpec,
+				Start:   startDate,
+				End:     endDate,
+				Project: projectSpec,
+
+				FailedRunsOnly: true,
+			}
+			emptyUUID := ""
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByName", ctx, jobName, namespaceSpec).Return(jobSpec, nil)
+			jobService.On("Replay", ctx, replayWorkerRequest).Return(emptyUUID, job.ErrReplayNoFailedRuns)
+			defer jobService.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", ctx, namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+			adapter := v1.NewAdapter(nil, nil)
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				jobService,
+				nil,
+				nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				adapter,
+				nil,
+				nil,
+				nil,
+			)
+			replayRequest := pb.ReplayRequest{
+				ProjectName: projectName,
+				Namespace:   namespaceSpec.Name,
+				JobName:     jobName,
+				StartDate:   startDate.Format(timeLayout),
+				EndDate:     endDate.Format(timeLayout),
+
+				FailedRunsOnly: true,
+			}
+			replayResponse, err := runtimeServiceServer.Replay(ctx, &replayRequest)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), job.ErrReplayNoFailedRuns.Error())
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Nil(t, replayResponse)
+		})
 	})
 
 	t.Run("GetReplayStatus", func(t *testing.T) {
@@ -2489,6 +3589,119 @@ func TestRuntimeServiceServer(t *testing.T) {
 		})
 	})
 
+	t.Run("CancelReplay", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+		}
+		replayID := uuid.Must(uuid.NewRandom())
+
+		t.Run("should cancel replay and fail its runs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("CancelReplay", ctx, models.ReplayRequest{ID: replayID, Project: projectSpec}, true).Return(nil)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				jobService, nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.CancelReplay(ctx, &pb.CancelReplayRequest{
+				ProjectName: projectName,
+				Id:          replayID.String(),
+				FailRuns:    true,
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, &pb.CancelReplayResponse{Success: true, Message: "cancelled successfully"}, resp)
+		})
+		t.Run("should return error if replay id is invalid", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				nil, nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.CancelReplay(ctx, &pb.CancelReplayRequest{
+				ProjectName: projectName,
+				Id:          "invalid-id",
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Nil(t, resp)
+		})
+		t.Run("should return error if replay is already finished", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", ctx, projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("CancelReplay", ctx, models.ReplayRequest{ID: replayID, Project: projectSpec}, false).
+				Return(errors.Wrap(job.ErrReplayNotCancellable, "replay is success"))
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				log,
+				"Version",
+				jobService, nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.CancelReplay(ctx, &pb.CancelReplayRequest{
+				ProjectName: projectName,
+				Id:          replayID.String(),
+			})
+
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Nil(t, resp)
+		})
+	})
+
 	t.Run("BackupDryRun", func(t *testing.T) {
 		projectName := "a-data-project"
 		projectSpec := models.ProjectSpec{
@@ -3797,3 +5010,12 @@ func TestRuntimeServiceServer(t *testing.T) {
 		})
 	})
 }
+
+// kubernetesScheduler is a mocked scheduler named as kubernetes
+type kubernetesScheduler struct {
+	mock.Scheduler
+}
+
+func (*kubernetesScheduler) GetName() string {
+	return "kubernetes"
+}
@@ -47,6 +47,9 @@ type RuntimeServiceClient interface {
 	RegisterProjectNamespace(ctx context.Context, in *RegisterProjectNamespaceRequest, opts ...grpc.CallOption) (*RegisterProjectNamespaceResponse, error)
 	// RegisterSecret creates a new secret of a project
 	RegisterSecret(ctx context.Context, in *RegisterSecretRequest, opts ...grpc.CallOption) (*RegisterSecretResponse, error)
+	// RegisterProjectJobTemplate overrides the template used to compile jobs
+	// of the project, template is verified by compiling a few jobs with it
+	RegisterProjectJobTemplate(ctx context.Context, in *RegisterProjectJobTemplateRequest, opts ...grpc.CallOption) (*RegisterProjectJobTemplateResponse, error)
 	// ListProjects returns list of registered projects and configurations
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	// ListProjectNamespaces returns list of namespaces of a project
@@ -56,6 +59,9 @@ type RuntimeServiceClient interface {
 	RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error)
 	// JobStatus returns the current and past run status of jobs
 	JobStatus(ctx context.Context, in *JobStatusRequest, opts ...grpc.CallOption) (*JobStatusResponse, error)
+	// JobRunDetails returns execution details of every task, hook and sensor
+	// of a job run
+	JobRunDetails(ctx context.Context, in *JobRunDetailsRequest, opts ...grpc.CallOption) (*JobRunDetailsResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
 	RegisterJobEvent(ctx context.Context, in *RegisterJobEventRequest, opts ...grpc.CallOption) (*RegisterJobEventResponse, error)
 	// GetWindow provides the start and end dates provided a scheduled date
@@ -77,12 +83,25 @@ type RuntimeServiceClient interface {
 	Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (*ReplayResponse, error)
 	GetReplayStatus(ctx context.Context, in *GetReplayStatusRequest, opts ...grpc.CallOption) (*GetReplayStatusResponse, error)
 	ListReplays(ctx context.Context, in *ListReplaysRequest, opts ...grpc.CallOption) (*ListReplaysResponse, error)
+	CancelReplay(ctx context.Context, in *CancelReplayRequest, opts ...grpc.CallOption) (*CancelReplayResponse, error)
 	BackupDryRun(ctx context.Context, in *BackupDryRunRequest, opts ...grpc.CallOption) (*BackupDryRunResponse, error)
 	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
 	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
 	// RunJob creates a job run and executes all included tasks/hooks instantly
 	// this doesn't necessarily deploy the job in db first
 	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*RunJobResponse, error)
+	CancelJobRun(ctx context.Context, in *CancelJobRunRequest, opts ...grpc.CallOption) (*CancelJobRunResponse, error)
+	// PauseJob stops scheduling new runs of a job till it is resumed
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error)
+	// ResumeJob starts scheduling runs of a paused job again
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error)
+	// TriggerJobRun creates an adhoc run of a job on the batch scheduler
+	TriggerJobRun(ctx context.Context, in *TriggerJobRunRequest, opts ...grpc.CallOption) (*TriggerJobRunResponse, error)
+	// CheckJobDrift compares jobs deployed on scheduler with the ones stored
+	// in optimus for a namespace, it is meant for administrators
+	CheckJobDrift(ctx context.Context, in *CheckJobDriftRequest, opts ...grpc.CallOption) (*CheckJobDriftResponse, error)
+	// GetInstanceLogs streams output of an instance of job run
+	GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (RuntimeService_GetInstanceLogsClient, error)
 }
 
 type runtimeServiceClient struct {
@@ -247,6 +266,15 @@ func (c *runtimeServiceClient) RegisterSecret(ctx context.Context, in *RegisterS
 	return out, nil
 }
 
+func (c *runtimeServiceClient) RegisterProjectJobTemplate(ctx context.Context, in *RegisterProjectJobTemplateRequest, opts ...grpc.CallOption) (*RegisterProjectJobTemplateResponse, error) {
+	out := new(RegisterProjectJobTemplateResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/RegisterProjectJobTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	out := new(ListProjectsResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/ListProjects", in, out, opts...)
@@ -283,6 +311,15 @@ func (c *runtimeServiceClient) JobStatus(ctx context.Context, in *JobStatusReque
 	return out, nil
 }
 
+func (c *runtimeServiceClient) JobRunDetails(ctx context.Context, in *JobRunDetailsRequest, opts ...grpc.CallOption) (*JobRunDetailsResponse, error) {
+	out := new(JobRunDetailsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/JobRunDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) RegisterJobEvent(ctx context.Context, in *RegisterJobEventRequest, opts ...grpc.CallOption) (*RegisterJobEventResponse, error) {
 	out := new(RegisterJobEventResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/RegisterJobEvent", in, out, opts...)
@@ -405,6 +442,15 @@ func (c *runtimeServiceClient) ListReplays(ctx context.Context, in *ListReplaysR
 	return out, nil
 }
 
+func (c *runtimeServiceClient) CancelReplay(ctx context.Context, in *CancelReplayRequest, opts ...grpc.CallOption) (*CancelReplayResponse, error) {
+	out := new(CancelReplayResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/CancelReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) BackupDryRun(ctx context.Context, in *BackupDryRunRequest, opts ...grpc.CallOption) (*BackupDryRunResponse, error) {
 	out := new(BackupDryRunResponse)
 	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/BackupDryRun", in, out, opts...)
@@ -441,6 +487,83 @@ func (c *runtimeServiceClient) RunJob(ctx context.Context, in *RunJobRequest, op
 	return out, nil
 }
 
+func (c *runtimeServiceClient) CancelJobRun(ctx context.Context, in *CancelJobRunRequest, opts ...grpc.CallOption) (*CancelJobRunResponse, error) {
+	out := new(CancelJobRunResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/CancelJobRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*PauseJobResponse, error) {
+	out := new(PauseJobResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*ResumeJobResponse, error) {
+	out := new(ResumeJobResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) TriggerJobRun(ctx context.Context, in *TriggerJobRunRequest, opts ...grpc.CallOption) (*TriggerJobRunResponse, error) {
+	out := new(TriggerJobRunResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/TriggerJobRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CheckJobDrift(ctx context.Context, in *CheckJobDriftRequest, opts ...grpc.CallOption) (*CheckJobDriftResponse, error) {
+	out := new(CheckJobDriftResponse)
+	err := c.cc.Invoke(ctx, "/odpf.optimus.RuntimeService/CheckJobDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetInstanceLogs(ctx context.Context, in *GetInstanceLogsRequest, opts ...grpc.CallOption) (RuntimeService_GetInstanceLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[3], "/odpf.optimus.RuntimeService/GetInstanceLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceGetInstanceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_GetInstanceLogsClient interface {
+	Recv() (*GetInstanceLogsResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceGetInstanceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceGetInstanceLogsClient) Recv() (*GetInstanceLogsResponse, error) {
+	m := new(GetInstanceLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility
@@ -474,6 +597,9 @@ type RuntimeServiceServer interface {
 	RegisterProjectNamespace(context.Context, *RegisterProjectNamespaceRequest) (*RegisterProjectNamespaceResponse, error)
 	// RegisterSecret creates a new secret of a project
 	RegisterSecret(context.Context, *RegisterSecretRequest) (*RegisterSecretResponse, error)
+	// RegisterProjectJobTemplate overrides the template used to compile jobs
+	// of the project, template is verified by compiling a few jobs with it
+	RegisterProjectJobTemplate(context.Context, *RegisterProjectJobTemplateRequest) (*RegisterProjectJobTemplateResponse, error)
 	// ListProjects returns list of registered projects and configurations
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	// ListProjectNamespaces returns list of namespaces of a project
@@ -483,6 +609,9 @@ type RuntimeServiceServer interface {
 	RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error)
 	// JobStatus returns the current and past run status of jobs
 	JobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error)
+	// JobRunDetails returns execution details of every task, hook and sensor
+	// of a job run
+	JobRunDetails(context.Context, *JobRunDetailsRequest) (*JobRunDetailsResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
 	RegisterJobEvent(context.Context, *RegisterJobEventRequest) (*RegisterJobEventResponse, error)
 	// GetWindow provides the start and end dates provided a scheduled date
@@ -504,12 +633,25 @@ type RuntimeServiceServer interface {
 	Replay(context.Context, *ReplayRequest) (*ReplayResponse, error)
 	GetReplayStatus(context.Context, *GetReplayStatusRequest) (*GetReplayStatusResponse, error)
 	ListReplays(context.Context, *ListReplaysRequest) (*ListReplaysResponse, error)
+	CancelReplay(context.Context, *CancelReplayRequest) (*CancelReplayResponse, error)
 	BackupDryRun(context.Context, *BackupDryRunRequest) (*BackupDryRunResponse, error)
 	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
 	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
 	// RunJob creates a job run and executes all included tasks/hooks instantly
 	// this doesn't necessarily deploy the job in db first
 	RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error)
+	CancelJobRun(context.Context, *CancelJobRunRequest) (*CancelJobRunResponse, error)
+	// PauseJob stops scheduling new runs of a job till it is resumed
+	PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error)
+	// ResumeJob starts scheduling runs of a paused job again
+	ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error)
+	// TriggerJobRun creates an adhoc run of a job on the batch scheduler
+	TriggerJobRun(context.Context, *TriggerJobRunRequest) (*TriggerJobRunResponse, error)
+	// CheckJobDrift compares jobs deployed on scheduler with the ones stored
+	// in optimus for a namespace, it is meant for administrators
+	CheckJobDrift(context.Context, *CheckJobDriftRequest) (*CheckJobDriftResponse, error)
+	// GetInstanceLogs streams output of an instance of job run
+	GetInstanceLogs(*GetInstanceLogsRequest, RuntimeService_GetInstanceLogsServer) error
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -553,6 +695,9 @@ func (UnimplementedRuntimeServiceServer) RegisterProjectNamespace(context.Contex
 func (UnimplementedRuntimeServiceServer) RegisterSecret(context.Context, *RegisterSecretRequest) (*RegisterSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecret not implemented")
 }
+func (UnimplementedRuntimeServiceServer) RegisterProjectJobTemplate(context.Context, *RegisterProjectJobTemplateRequest) (*RegisterProjectJobTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProjectJobTemplate not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
@@ -565,6 +710,9 @@ func (UnimplementedRuntimeServiceServer) RegisterInstance(context.Context, *Regi
 func (UnimplementedRuntimeServiceServer) JobStatus(context.Context, *JobStatusRequest) (*JobStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JobStatus not implemented")
 }
+func (UnimplementedRuntimeServiceServer) JobRunDetails(context.Context, *JobRunDetailsRequest) (*JobRunDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobRunDetails not implemented")
+}
 func (UnimplementedRuntimeServiceServer) RegisterJobEvent(context.Context, *RegisterJobEventRequest) (*RegisterJobEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterJobEvent not implemented")
 }
@@ -598,6 +746,9 @@ func (UnimplementedRuntimeServiceServer) GetReplayStatus(context.Context, *GetRe
 func (UnimplementedRuntimeServiceServer) ListReplays(context.Context, *ListReplaysRequest) (*ListReplaysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReplays not implemented")
 }
+func (UnimplementedRuntimeServiceServer) CancelReplay(context.Context, *CancelReplayRequest) (*CancelReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplay not implemented")
+}
 func (UnimplementedRuntimeServiceServer) BackupDryRun(context.Context, *BackupDryRunRequest) (*BackupDryRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackupDryRun not implemented")
 }
@@ -610,6 +761,24 @@ func (UnimplementedRuntimeServiceServer) ListBackups(context.Context, *ListBacku
 func (UnimplementedRuntimeServiceServer) RunJob(context.Context, *RunJobRequest) (*RunJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunJob not implemented")
 }
+func (UnimplementedRuntimeServiceServer) CancelJobRun(context.Context, *CancelJobRunRequest) (*CancelJobRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobRun not implemented")
+}
+func (UnimplementedRuntimeServiceServer) PauseJob(context.Context, *PauseJobRequest) (*PauseJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ResumeJob(context.Context, *ResumeJobRequest) (*ResumeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedRuntimeServiceServer) TriggerJobRun(context.Context, *TriggerJobRunRequest) (*TriggerJobRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJobRun not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CheckJobDrift(context.Context, *CheckJobDriftRequest) (*CheckJobDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckJobDrift not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetInstanceLogs(*GetInstanceLogsRequest, RuntimeService_GetInstanceLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetInstanceLogs not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 
 // UnsafeRuntimeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -845,6 +1014,24 @@ func _RuntimeService_RegisterSecret_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_RegisterProjectJobTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterProjectJobTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RegisterProjectJobTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/RegisterProjectJobTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RegisterProjectJobTemplate(ctx, req.(*RegisterProjectJobTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
@@ -917,6 +1104,24 @@ func _RuntimeService_JobStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_JobRunDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRunDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).JobRunDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/JobRunDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).JobRunDetails(ctx, req.(*JobRunDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_RegisterJobEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterJobEventRequest)
 	if err := dec(in); err != nil {
@@ -1118,6 +1323,24 @@ func _RuntimeService_ListReplays_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_CancelReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CancelReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/CancelReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CancelReplay(ctx, req.(*CancelReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_BackupDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BackupDryRunRequest)
 	if err := dec(in); err != nil {
@@ -1190,6 +1413,117 @@ func _RuntimeService_RunJob_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_CancelJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CancelJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/CancelJobRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CancelJobRun(ctx, req.(*CancelJobRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_TriggerJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).TriggerJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/TriggerJobRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).TriggerJobRun(ctx, req.(*TriggerJobRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CheckJobDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckJobDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CheckJobDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.optimus.RuntimeService/CheckJobDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CheckJobDrift(ctx, req.(*CheckJobDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetInstanceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetInstanceLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).GetInstanceLogs(m, &runtimeServiceGetInstanceLogsServer{stream})
+}
+
+type RuntimeService_GetInstanceLogsServer interface {
+	Send(*GetInstanceLogsResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceGetInstanceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceGetInstanceLogsServer) Send(m *GetInstanceLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1237,6 +1571,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterSecret",
 			Handler:    _RuntimeService_RegisterSecret_Handler,
 		},
+		{
+			MethodName: "RegisterProjectJobTemplate",
+			Handler:    _RuntimeService_RegisterProjectJobTemplate_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _RuntimeService_ListProjects_Handler,
@@ -1253,6 +1591,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JobStatus",
 			Handler:    _RuntimeService_JobStatus_Handler,
 		},
+		{
+			MethodName: "JobRunDetails",
+			Handler:    _RuntimeService_JobRunDetails_Handler,
+		},
 		{
 			MethodName: "RegisterJobEvent",
 			Handler:    _RuntimeService_RegisterJobEvent_Handler,
@@ -1293,6 +1635,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReplays",
 			Handler:    _RuntimeService_ListReplays_Handler,
 		},
+		{
+			MethodName: "CancelReplay",
+			Handler:    _RuntimeService_CancelReplay_Handler,
+		},
 		{
 			MethodName: "BackupDryRun",
 			Handler:    _RuntimeService_BackupDryRun_Handler,
@@ -1309,6 +1655,26 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunJob",
 			Handler:    _RuntimeService_RunJob_Handler,
 		},
+		{
+			MethodName: "CancelJobRun",
+			Handler:    _RuntimeService_CancelJobRun_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _RuntimeService_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _RuntimeService_ResumeJob_Handler,
+		},
+		{
+			MethodName: "TriggerJobRun",
+			Handler:    _RuntimeService_TriggerJobRun_Handler,
+		},
+		{
+			MethodName: "CheckJobDrift",
+			Handler:    _RuntimeService_CheckJobDrift_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1326,6 +1692,11 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RuntimeService_DeployResourceSpecification_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetInstanceLogs",
+			Handler:       _RuntimeService_GetInstanceLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "odpf/optimus/runtime_service.proto",
 }
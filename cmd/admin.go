@@ -13,7 +13,10 @@ func adminCommand(l log.Logger, pluginRepo models.PluginRepository) *cli.Command
 		Short: "administration commands, should not be used by user",
 	}
 	cmd.AddCommand(adminBuildCommand(l))
+	cmd.AddCommand(adminReportCommand(l))
 	cmd.AddCommand(adminGetCommand(l, pluginRepo))
+	cmd.AddCommand(adminDriftCommand(l))
+	cmd.AddCommand(adminTemplateCommand(l))
 	return cmd
 }
 
@@ -27,6 +30,16 @@ func adminBuildCommand(l log.Logger) *cli.Command {
 	return cmd
 }
 
+// adminReportCommand reports status of a resource
+func adminReportCommand(l log.Logger) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "report",
+		Short: "Report status of a job run executed by scheduler",
+	}
+	cmd.AddCommand(adminReportInstanceCommand(l))
+	return cmd
+}
+
 // adminGetCommand gets a resource
 func adminGetCommand(l log.Logger, pluginRepo models.PluginRepository) *cli.Command {
 	cmd := &cli.Command{
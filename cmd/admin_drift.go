@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	adminDriftTimeout = time.Minute * 5
+)
+
+func adminDriftCommand(l log.Logger) *cli.Command {
+	var (
+		optimusHost string
+		projectName string
+		namespace   string
+		repair      bool
+	)
+	cmd := &cli.Command{
+		Use:     "drift",
+		Short:   "Compare jobs deployed on scheduler with the jobs stored in optimus",
+		Example: `optimus admin drift --project "project-id" --namespace "kush" --host "localhost:9100"`,
+		Args:    cli.NoArgs,
+	}
+	cmd.Flags().StringVar(&projectName, "project", "", "name of the tenant")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "namespace of the tenant")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&optimusHost, "host", "", "optimus service endpoint url")
+	cmd.MarkFlagRequired("host")
+	cmd.Flags().BoolVar(&repair, "repair", false, "deploy missing and modified jobs, delete orphaned jobs")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		l.Info(fmt.Sprintf("checking drift for project %s, namespace %s at %s\nplease wait...",
+			projectName, namespace, optimusHost))
+		return checkJobDriftRequest(l, optimusHost, &pb.CheckJobDriftRequest{
+			ProjectName: projectName,
+			Namespace:   namespace,
+			Repair:      repair,
+		})
+	}
+	return cmd
+}
+
+func checkJobDriftRequest(l log.Logger, host string, req *pb.CheckJobDriftRequest) error {
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("can't reach optimus service, timing out")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminDriftTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	driftResponse, err := runtime.CheckJobDrift(timeoutCtx, req)
+	if err != nil {
+		return errors.Wrapf(err, "request failed for namespace %s", req.Namespace)
+	}
+
+	if len(driftResponse.OrphanedJobs) == 0 && len(driftResponse.MissingJobs) == 0 && len(driftResponse.ModifiedJobs) == 0 {
+		l.Info(coloredSuccess("scheduler is in sync"))
+		return nil
+	}
+	l.Info(fmt.Sprintf("orphaned: %s", strings.Join(driftResponse.OrphanedJobs, ", ")))
+	l.Info(fmt.Sprintf("missing: %s", strings.Join(driftResponse.MissingJobs, ", ")))
+	l.Info(fmt.Sprintf("modified: %s", strings.Join(driftResponse.ModifiedJobs, ", ")))
+	if driftResponse.Repaired {
+		l.Info(coloredNotice("drift is repaired"))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	adminReportInstanceTimeout = time.Second * 30
+)
+
+func adminReportInstanceCommand(l log.Logger) *cli.Command {
+	var (
+		optimusHost   string
+		projectName   string
+		namespaceName string
+		scheduledAt   string
+		runType       string
+		runName       string
+		runStatus     string
+	)
+	cmd := &cli.Command{
+		Use:     "instance",
+		Short:   "Reports the final status of a Job instance executed by scheduler",
+		Example: "optimus admin report instance sample_replace --project \"project-id\" --namespace \"namespace\" --status success",
+		Args:    cli.MinimumNArgs(1),
+	}
+
+	cmd.Flags().StringVar(&scheduledAt, "scheduled-at", "", "time at which the job was scheduled for execution")
+	cmd.MarkFlagRequired("scheduled-at")
+	cmd.Flags().StringVar(&runType, "type", "", "type of task, could be base/hook")
+	cmd.MarkFlagRequired("type")
+	cmd.Flags().StringVar(&runName, "name", "", "name of task, could be bq2bq/transporter/predator")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&runStatus, "status", "", "final status of the instance, could be success/failed")
+	cmd.MarkFlagRequired("status")
+
+	cmd.Flags().StringVar(&projectName, "project", "", "name of the tenant")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&namespaceName, "namespace", "", "namespace of the job")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&optimusHost, "host", "", "optimus service endpoint url")
+	cmd.MarkFlagRequired("host")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		jobName := args[0]
+		l.Info(fmt.Sprintf("reporting %s %s of job %s scheduled at %s as %s", runType, runName, jobName, scheduledAt, runStatus))
+		return reportInstanceRequest(l, jobName, optimusHost, projectName, namespaceName, scheduledAt, runType, runName, runStatus)
+	}
+	return cmd
+}
+
+// reportInstanceRequest registers a success or failure event of the
+// instance, optimus updates status of the instance using it
+func reportInstanceRequest(l log.Logger, jobName, host, projectName, namespaceName, scheduledAt, runType, runName,
+	runStatus string) (err error) {
+	if _, err := time.Parse(models.InstanceScheduledAtTimeLayout, scheduledAt); err != nil {
+		return errors.Wrapf(err, "invalid time format, please use %s", models.InstanceScheduledAtTimeLayout)
+	}
+	var eventType pb.JobEvent_Type
+	switch strings.ToLower(runStatus) {
+	case models.RunStateSuccess.String():
+		eventType = pb.JobEvent_SUCCESS
+	case models.RunStateFailed.String():
+		eventType = pb.JobEvent_FAILURE
+	default:
+		return errors.Errorf("invalid status %s, should be one of %s, %s", runStatus, models.RunStateSuccess, models.RunStateFailed)
+	}
+	eventValues, err := structpb.NewStruct(map[string]interface{}{
+		models.JobEventKeyScheduledAt:  scheduledAt,
+		models.JobEventKeyInstanceType: strings.ToLower(runType),
+		models.JobEventKeyInstanceName: runName,
+	})
+	if err != nil {
+		return err
+	}
+
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("can't reach optimus service, timing out")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminReportInstanceTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	if _, err := runtime.RegisterJobEvent(timeoutCtx, &pb.RegisterJobEventRequest{
+		ProjectName: projectName,
+		JobName:     jobName,
+		Namespace:   namespaceName,
+		Event: &pb.JobEvent{
+			Type:  eventType,
+			Value: eventValues,
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "request failed for job %s", jobName)
+	}
+	return nil
+}
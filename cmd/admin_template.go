@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	adminTemplateTimeout = time.Minute * 2
+)
+
+func adminTemplateCommand(l log.Logger) *cli.Command {
+	var (
+		optimusHost string
+		projectName string
+		reset       bool
+	)
+	cmd := &cli.Command{
+		Use:   "template [path]",
+		Short: "Register a custom template used by scheduler to compile jobs of the project",
+		Example: `optimus admin template ./dag.py.tmpl --project "project-id" --host "localhost:9100"
+optimus admin template --reset --project "project-id" --host "localhost:9100"`,
+		Args: cli.MaximumNArgs(1),
+	}
+	cmd.Flags().StringVar(&projectName, "project", "", "name of the tenant")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&optimusHost, "host", "", "optimus service endpoint url")
+	cmd.MarkFlagRequired("host")
+	cmd.Flags().BoolVar(&reset, "reset", false, "remove custom template and use the default template of scheduler")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		var template []byte
+		if !reset {
+			if len(args) == 0 {
+				return errors.New("path to template file is required unless --reset is set")
+			}
+			var err error
+			if template, err = ioutil.ReadFile(args[0]); err != nil {
+				return errors.Wrapf(err, "failed to read template %s", args[0])
+			}
+		}
+		l.Info(fmt.Sprintf("registering job template for project %s at %s\nplease wait...", projectName, optimusHost))
+		return registerJobTemplateRequest(l, optimusHost, &pb.RegisterProjectJobTemplateRequest{
+			ProjectName: projectName,
+			Template:    string(template),
+		})
+	}
+	return cmd
+}
+
+func registerJobTemplateRequest(l log.Logger, host string, req *pb.RegisterProjectJobTemplateRequest) error {
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("can't reach optimus service, timing out")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminTemplateTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	if _, err := runtime.RegisterProjectJobTemplate(timeoutCtx, req); err != nil {
+		return errors.Wrapf(err, "request failed for project %s", req.ProjectName)
+	}
+	l.Info(coloredSuccess("job template registered"))
+	return nil
+}
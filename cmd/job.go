@@ -0,0 +1,430 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	cancelJobRunTimeout    = time.Minute * 1
+	getInstanceLogsTimeout = time.Minute * 1
+	pauseJobTimeout        = time.Minute * 1
+	triggerJobRunTimeout   = time.Minute * 1
+	jobRunDetailsTimeout   = time.Minute * 1
+)
+
+func jobCommand(l log.Logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "job",
+		Short: "Manage runs of the jobs executed on optimus cluster",
+	}
+	cmd.AddCommand(jobCancelSubCommand(l, conf))
+	cmd.AddCommand(jobLogsSubCommand(l, conf))
+	cmd.AddCommand(jobPauseSubCommand(l, conf))
+	cmd.AddCommand(jobResumeSubCommand(l, conf))
+	cmd.AddCommand(jobTriggerSubCommand(l, conf))
+	cmd.AddCommand(jobDetailsSubCommand(l, conf))
+	return cmd
+}
+
+func jobCancelSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		namespace   string
+		scheduledAt string
+	)
+	cmd := &cli.Command{
+		Use:     "cancel",
+		Short:   "cancel a run of the job which is queued or under execution",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job cancel <job_name> --project g-optimus --namespace kush --scheduled-at 2021-11-03T00:00:00Z",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace under the project")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&scheduledAt, "scheduled-at", "", fmt.Sprintf("scheduled time of the run in %s format", models.InstanceScheduledAtTimeLayout))
+	cmd.MarkFlagRequired("scheduled-at")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		scheduledTime, err := time.Parse(models.InstanceScheduledAtTimeLayout, scheduledAt)
+		if err != nil {
+			return errors.Wrapf(err, "invalid scheduled time %s", scheduledAt)
+		}
+		return cancelJobRunRequest(l, conf.GetHost(), &pb.CancelJobRunRequest{
+			ProjectName: projectName,
+			Namespace:   namespace,
+			JobName:     args[0],
+			ScheduledAt: timestamppb.New(scheduledTime),
+		})
+	}
+	return cmd
+}
+
+func cancelJobRunRequest(l log.Logger, host string, req *pb.CancelJobRunRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info(coloredError("can't reach optimus service"))
+		}
+		return err
+	}
+	defer conn.Close()
+
+	cancelTimeoutCtx, cancelCancel := context.WithTimeout(context.Background(), cancelJobRunTimeout)
+	defer cancelCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	cancelResponse, err := runtime.CancelJobRun(cancelTimeoutCtx, req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("process took too long, timing out")
+		}
+		return errors.Wrapf(err, "request failed for job %s", req.JobName)
+	}
+	l.Info(fmt.Sprintf("run of job %s is %s", req.JobName, coloredNotice(cancelResponse.State)))
+	return nil
+}
+
+func jobLogsSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName  string
+		namespace    string
+		scheduledAt  string
+		instanceName string
+		follow       bool
+	)
+	cmd := &cli.Command{
+		Use:     "logs",
+		Short:   "print logs of a task or hook executed for the run of job",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job logs <job_name> --project g-optimus --namespace kush --scheduled-at 2021-11-03T00:00:00Z --follow",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace under the project")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&scheduledAt, "scheduled-at", "", fmt.Sprintf("scheduled time of the run in %s format", models.InstanceScheduledAtTimeLayout))
+	cmd.MarkFlagRequired("scheduled-at")
+	cmd.Flags().StringVarP(&instanceName, "instance", "i", "", "name of the task or hook, defaults to task of the job")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep streaming logs till the instance finishes")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		scheduledTime, err := time.Parse(models.InstanceScheduledAtTimeLayout, scheduledAt)
+		if err != nil {
+			return errors.Wrapf(err, "invalid scheduled time %s", scheduledAt)
+		}
+		return getInstanceLogsRequest(l, conf.GetHost(), c.OutOrStdout(), &pb.GetInstanceLogsRequest{
+			ProjectName:  projectName,
+			Namespace:    namespace,
+			JobName:      args[0],
+			ScheduledAt:  timestamppb.New(scheduledTime),
+			InstanceName: instanceName,
+			Follow:       follow,
+		})
+	}
+	return cmd
+}
+
+func getInstanceLogsRequest(l log.Logger, host string, out io.Writer, req *pb.GetInstanceLogsRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info(coloredError("can't reach optimus service"))
+		}
+		return err
+	}
+	defer conn.Close()
+
+	var (
+		logsCtx    context.Context
+		logsCancel context.CancelFunc
+	)
+	if req.Follow {
+		// following logs lasts as long as the instance executes
+		logsCtx, logsCancel = context.WithCancel(context.Background())
+	} else {
+		logsCtx, logsCancel = context.WithTimeout(context.Background(), getInstanceLogsTimeout)
+	}
+	defer logsCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	respStream, err := runtime.GetInstanceLogs(logsCtx, req)
+	if err != nil {
+		return errors.Wrapf(err, "request failed for job %s", req.JobName)
+	}
+	attempt := int32(-1)
+	for {
+		resp, err := respStream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Info("process took too long, timing out")
+			}
+			return errors.Wrapf(err, "failed to receive logs of job %s", req.JobName)
+		}
+		if resp.Attempt != attempt {
+			attempt = resp.Attempt
+			l.Info(coloredNotice(fmt.Sprintf("%s attempt %d", resp.InstanceName, resp.Attempt)))
+		}
+		if _, err := out.Write(resp.Logs); err != nil {
+			return err
+		}
+	}
+}
+
+func jobPauseSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		namespace   string
+	)
+	cmd := &cli.Command{
+		Use:     "pause",
+		Short:   "stop scheduling new runs of the job till it is resumed",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job pause <job_name> --project g-optimus --namespace kush",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace under the project")
+	cmd.MarkFlagRequired("namespace")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		return pauseJobRequest(l, conf.GetHost(), projectName, namespace, args[0], true)
+	}
+	return cmd
+}
+
+func jobResumeSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		namespace   string
+	)
+	cmd := &cli.Command{
+		Use:     "resume",
+		Short:   "start scheduling runs of a paused job again",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job resume <job_name> --project g-optimus --namespace kush",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace under the project")
+	cmd.MarkFlagRequired("namespace")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		return pauseJobRequest(l, conf.GetHost(), projectName, namespace, args[0], false)
+	}
+	return cmd
+}
+
+// pauseJobRequest pauses the job if paused is true else resumes it
+func pauseJobRequest(l log.Logger, host, projectName, namespace, jobName string, paused bool) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info(coloredError("can't reach optimus service"))
+		}
+		return err
+	}
+	defer conn.Close()
+
+	pauseTimeoutCtx, pauseCancel := context.WithTimeout(context.Background(), pauseJobTimeout)
+	defer pauseCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	state := "paused"
+	if paused {
+		_, err = runtime.PauseJob(pauseTimeoutCtx, &pb.PauseJobRequest{
+			ProjectName: projectName,
+			Namespace:   namespace,
+			JobName:     jobName,
+		})
+	} else {
+		state = "resumed"
+		_, err = runtime.ResumeJob(pauseTimeoutCtx, &pb.ResumeJobRequest{
+			ProjectName: projectName,
+			Namespace:   namespace,
+			JobName:     jobName,
+		})
+	}
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("process took too long, timing out")
+		}
+		return errors.Wrapf(err, "request failed for job %s", jobName)
+	}
+	l.Info(fmt.Sprintf("job %s is %s", jobName, coloredNotice(state)))
+	return nil
+}
+
+func jobTriggerSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		namespace   string
+		scheduledAt string
+		runConfig   map[string]string
+	)
+	cmd := &cli.Command{
+		Use:     "trigger",
+		Short:   "create an adhoc run of the job on the scheduler",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job trigger <job_name> --project g-optimus --namespace kush --scheduled-at 2021-11-03T00:00:00Z --config BACKFILL=true",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace under the project")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&scheduledAt, "scheduled-at", "", fmt.Sprintf("scheduled time of the run in %s format", models.InstanceScheduledAtTimeLayout))
+	cmd.MarkFlagRequired("scheduled-at")
+	cmd.Flags().StringToStringVarP(&runConfig, "config", "c", nil, "configuration of the run as key=value, can be repeated")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		scheduledTime, err := time.Parse(models.InstanceScheduledAtTimeLayout, scheduledAt)
+		if err != nil {
+			return errors.Wrapf(err, "invalid scheduled time %s", scheduledAt)
+		}
+		return triggerJobRunRequest(l, conf.GetHost(), &pb.TriggerJobRunRequest{
+			ProjectName: projectName,
+			Namespace:   namespace,
+			JobName:     args[0],
+			ScheduledAt: timestamppb.New(scheduledTime),
+			Config:      runConfig,
+		})
+	}
+	return cmd
+}
+
+func triggerJobRunRequest(l log.Logger, host string, req *pb.TriggerJobRunRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info(coloredError("can't reach optimus service"))
+		}
+		return err
+	}
+	defer conn.Close()
+
+	triggerTimeoutCtx, triggerCancel := context.WithTimeout(context.Background(), triggerJobRunTimeout)
+	defer triggerCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	if _, err = runtime.TriggerJobRun(triggerTimeoutCtx, req); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("process took too long, timing out")
+		}
+		return errors.Wrapf(err, "request failed for job %s", req.JobName)
+	}
+	l.Info(fmt.Sprintf("run of job %s scheduled at %s is %s", req.JobName,
+		req.ScheduledAt.AsTime().Format(models.InstanceScheduledAtTimeLayout), coloredSuccess("triggered")))
+	return nil
+}
+
+func jobDetailsSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		scheduledAt string
+	)
+	cmd := &cli.Command{
+		Use:     "details",
+		Short:   "print execution details of every task, hook and sensor of a job run",
+		Args:    cli.ExactArgs(1),
+		Example: "optimus job details <job_name> --project g-optimus --scheduled-at 2021-11-03T00:00:00Z",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&scheduledAt, "scheduled-at", "", fmt.Sprintf("scheduled time of the run in %s format", models.InstanceScheduledAtTimeLayout))
+	cmd.MarkFlagRequired("scheduled-at")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		scheduledTime, err := time.Parse(models.InstanceScheduledAtTimeLayout, scheduledAt)
+		if err != nil {
+			return errors.Wrapf(err, "invalid scheduled time %s", scheduledAt)
+		}
+		return jobRunDetailsRequest(l, conf.GetHost(), &pb.JobRunDetailsRequest{
+			ProjectName: projectName,
+			JobName:     args[0],
+			ScheduledAt: timestamppb.New(scheduledTime),
+		})
+	}
+	return cmd
+}
+
+func jobRunDetailsRequest(l log.Logger, host string, req *pb.JobRunDetailsRequest) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info(coloredError("can't reach optimus service"))
+		}
+		return err
+	}
+	defer conn.Close()
+
+	detailsTimeoutCtx, detailsCancel := context.WithTimeout(context.Background(), jobRunDetailsTimeout)
+	defer detailsCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	detailsResponse, err := runtime.JobRunDetails(detailsTimeoutCtx, req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Info("process took too long, timing out")
+		}
+		return errors.Wrapf(err, "request failed for job %s", req.JobName)
+	}
+	printJobRunDetailsResponse(l, req.JobName, detailsResponse)
+	return nil
+}
+
+func printJobRunDetailsResponse(l log.Logger, jobName string, detailsResponse *pb.JobRunDetailsResponse) {
+	l.Info(fmt.Sprintf("run of job %s scheduled at %s is %s", jobName,
+		detailsResponse.ScheduledAt.AsTime().Format(models.InstanceScheduledAtTimeLayout),
+		coloredNotice(detailsResponse.State)))
+	table := tablewriter.NewWriter(l.Writer())
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Name",
+		"Type",
+		"State",
+		"Try",
+		"Started",
+		"Ended",
+		"Duration",
+	})
+
+	// instances which haven't started or ended yet have no timestamps
+	formatTime := func(ts *timestamppb.Timestamp) string {
+		if ts == nil {
+			return "-"
+		}
+		return ts.AsTime().Format(time.RFC3339)
+	}
+	for _, instance := range detailsResponse.Instances {
+		table.Append([]string{instance.Name, instance.Type, instance.State, fmt.Sprintf("%d", instance.TryNumber),
+			formatTime(instance.StartedAt), formatTime(instance.EndedAt), instance.Duration.AsDuration().String()})
+	}
+
+	table.Render()
+}
@@ -68,6 +68,7 @@ func replayCommand(l log.Logger, conf config.Provider) *cli.Command {
 	cmd.AddCommand(replayRunSubCommand(l, conf))
 	cmd.AddCommand(replayStatusSubCommand(l, conf))
 	cmd.AddCommand(replayListSubCommand(l, conf))
+	cmd.AddCommand(replayCancelSubCommand(l, conf))
 	return cmd
 }
 
@@ -77,6 +78,8 @@ func replayRunSubCommand(l log.Logger, conf config.Provider) *cli.Command {
 	var (
 		replayProject string
 		namespace     string
+		replayFilter  = &pb.ReplayFilter{}
+		runsMode      replayRunsMode
 	)
 
 	reCmd := &cli.Command{
@@ -88,6 +91,10 @@ This operation takes three arguments, first is DAG name[required]
 used in optimus specification, second is start date[required] of
 replay, third is end date[optional] of replay. 
 ReplayDryRun date ranges are inclusive.
+Downstream jobs replayed along with the DAG can be narrowed down
+using include/exclude filters, downstream of a skipped job is
+skipped as well. Only the failed runs of the date range can be
+replayed using --failed-only.
 		`,
 		Args: func(cmd *cli.Command, args []string) error {
 			if len(args) < 1 {
@@ -105,13 +112,26 @@ ReplayDryRun date ranges are inclusive.
 	reCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace of deployee")
 	reCmd.MarkFlagRequired("namespace")
 	reCmd.Flags().BoolVarP(&forceRun, "force", "f", forceRun, "run replay even if a previous run is in progress")
+	reCmd.Flags().StringSliceVar(&replayFilter.IncludeJobs, "include-job", nil, "replay only these downstream jobs")
+	reCmd.Flags().StringSliceVar(&replayFilter.ExcludeJobs, "exclude-job", nil, "skip these downstream jobs")
+	reCmd.Flags().StringSliceVar(&replayFilter.IncludeNamespaces, "include-namespace", nil, "replay only downstream jobs of these namespaces")
+	reCmd.Flags().StringSliceVar(&replayFilter.ExcludeNamespaces, "exclude-namespace", nil, "skip downstream jobs of these namespaces")
+	reCmd.Flags().StringToStringVar(&replayFilter.IncludeLabels, "include-label", nil, "replay only downstream jobs having all of these labels, e.g. team=data")
+	reCmd.Flags().StringToStringVar(&replayFilter.ExcludeLabels, "exclude-label", nil, "skip downstream jobs having any of these labels")
+	reCmd.Flags().Int32Var(&replayFilter.MaxDepth, "max-depth", 0, "levels of downstream to replay, 0 replays all of them")
+	reCmd.Flags().BoolVar(&replayFilter.RootOnly, "root-only", false, "replay only the provided job without its downstream")
+	reCmd.Flags().BoolVar(&runsMode.failedOnly, "failed-only", false, "replay only the runs which failed in scheduler")
+	reCmd.Flags().BoolVar(&runsMode.withDownstream, "failed-downstream", false, "replay downstream runs of the failed runs as well, used with --failed-only")
 
 	reCmd.RunE = func(cmd *cli.Command, args []string) error {
+		if runsMode.withDownstream && !runsMode.failedOnly {
+			return errors.New("--failed-downstream can only be used with --failed-only")
+		}
 		endDate := args[1]
 		if len(args) >= 3 {
 			endDate = args[2]
 		}
-		if err := printReplayExecutionTree(l, replayProject, namespace, args[0], args[1], endDate, conf); err != nil {
+		if err := printReplayExecutionTree(l, replayProject, namespace, args[0], args[1], endDate, conf, replayFilter, runsMode); err != nil {
 			return err
 		}
 		if dryRun {
@@ -133,7 +153,7 @@ ReplayDryRun date ranges are inclusive.
 			return nil
 		}
 
-		replayId, err := runReplayRequest(l, replayProject, namespace, args[0], args[1], endDate, conf, forceRun)
+		replayId, err := runReplayRequest(l, replayProject, namespace, args[0], args[1], endDate, conf, forceRun, replayFilter, runsMode)
 		if err != nil {
 			return err
 		}
@@ -143,7 +163,14 @@ ReplayDryRun date ranges are inclusive.
 	return reCmd
 }
 
-func printReplayExecutionTree(l log.Logger, projectName, namespace, jobName, startDate, endDate string, conf config.Provider) (err error) {
+// replayRunsMode selects which runs of the replay date range are replayed
+type replayRunsMode struct {
+	failedOnly     bool
+	withDownstream bool
+}
+
+func printReplayExecutionTree(l log.Logger, projectName, namespace, jobName, startDate, endDate string, conf config.Provider,
+	filter *pb.ReplayFilter, runsMode replayRunsMode) (err error) {
 	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
 	defer dialCancel()
 
@@ -162,11 +189,14 @@ func printReplayExecutionTree(l log.Logger, projectName, namespace, jobName, sta
 	l.Info("please wait...")
 	runtime := pb.NewRuntimeServiceClient(conn)
 	replayRequest := &pb.ReplayDryRunRequest{
-		ProjectName: projectName,
-		JobName:     jobName,
-		Namespace:   namespace,
-		StartDate:   startDate,
-		EndDate:     endDate,
+		ProjectName:          projectName,
+		JobName:              jobName,
+		Namespace:            namespace,
+		StartDate:            startDate,
+		EndDate:              endDate,
+		Filter:               filter,
+		FailedRunsOnly:       runsMode.failedOnly,
+		FailedRunsDownstream: runsMode.withDownstream,
 	}
 	replayDryRunResponse, err := runtime.ReplayDryRun(replayRequestTimeout, replayRequest)
 	if err != nil {
@@ -231,7 +261,8 @@ func printExecutionTree(instance *pb.ReplayExecutionTreeNode, tree treeprint.Tre
 	return tree
 }
 
-func runReplayRequest(l log.Logger, projectName, namespace, jobName, startDate, endDate string, conf config.Provider, forceRun bool) (string, error) {
+func runReplayRequest(l log.Logger, projectName, namespace, jobName, startDate, endDate string, conf config.Provider, forceRun bool,
+	filter *pb.ReplayFilter, runsMode replayRunsMode) (string, error) {
 	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
 	defer dialCancel()
 
@@ -253,12 +284,15 @@ func runReplayRequest(l log.Logger, projectName, namespace, jobName, startDate,
 	}
 	runtime := pb.NewRuntimeServiceClient(conn)
 	replayRequest := &pb.ReplayRequest{
-		ProjectName: projectName,
-		JobName:     jobName,
-		Namespace:   namespace,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		Force:       forceRun,
+		ProjectName:          projectName,
+		JobName:              jobName,
+		Namespace:            namespace,
+		StartDate:            startDate,
+		EndDate:              endDate,
+		Force:                forceRun,
+		Filter:               filter,
+		FailedRunsOnly:       runsMode.failedOnly,
+		FailedRunsDownstream: runsMode.withDownstream,
 	}
 	replayResponse, err := runtime.Replay(replayRequestTimeout, replayRequest)
 	if err != nil {
@@ -333,6 +367,8 @@ func printReplayStatusResponse(l log.Logger, replayStatusResponse *pb.GetReplayS
 		l.Info(fmt.Sprintf("\nThis replay is still %s", coloredNotice("running")))
 	} else if replayStatusResponse.State == models.ReplayStatusSuccess {
 		l.Info(fmt.Sprintf("\nThis replay has been marked as %s", coloredSuccess(models.ReplayStatusSuccess)))
+	} else if replayStatusResponse.State == models.ReplayStatusCancelled {
+		l.Info(fmt.Sprintf("\nThis replay has been %s", coloredNotice(models.ReplayStatusCancelled)))
 	}
 	l.Info(coloredNotice("Latest Instances Status"))
 	l.Info(fmt.Sprintf("%s", printStatusTree(replayStatusResponse.Response, treeprint.New())))
@@ -424,3 +460,62 @@ func printReplayListResponse(l log.Logger, replayListResponse *pb.ListReplaysRes
 
 	table.Render()
 }
+
+func replayCancelSubCommand(l log.Logger, conf config.Provider) *cli.Command {
+	var (
+		replayProject string
+		failRuns      bool
+	)
+
+	reCmd := &cli.Command{
+		Use:     "cancel",
+		Short:   "cancel an in-flight replay using its ID",
+		Example: "optimus replay cancel replay-id --fail-runs",
+		Long: `
+The cancel command stops a replay which is still clearing or running job runs.
+Runs already cleared by the replay keep executing in scheduler unless --fail-runs
+is provided, which marks the queued and running ones as failed.
+		`,
+		Args: func(cmd *cli.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("replay ID is required")
+			}
+			return nil
+		},
+	}
+	reCmd.Flags().StringVarP(&replayProject, "project", "p", "", "project name of optimus managed repository")
+	reCmd.MarkFlagRequired("project")
+	reCmd.Flags().BoolVar(&failRuns, "fail-runs", false, "mark queued and running runs of the replay as failed")
+	reCmd.RunE = func(cmd *cli.Command, args []string) error {
+		dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+		defer dialCancel()
+
+		conn, err := createConnection(dialTimeoutCtx, conf.GetHost())
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Info("can't reach optimus service")
+			}
+			return err
+		}
+		defer conn.Close()
+
+		replayRequestTimeout, replayRequestCancel := context.WithTimeout(context.Background(), replayTimeout)
+		defer replayRequestCancel()
+
+		runtime := pb.NewRuntimeServiceClient(conn)
+		_, err = runtime.CancelReplay(replayRequestTimeout, &pb.CancelReplayRequest{
+			Id:          args[0],
+			ProjectName: replayProject,
+			FailRuns:    failRuns,
+		})
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Info("replay request took too long, timing out")
+			}
+			return errors.Wrapf(err, "request cancelling replay %s is failed", args[0])
+		}
+		l.Info(fmt.Sprintf("replay %s has been %s", args[0], coloredNotice(models.ReplayStatusCancelled)))
+		return nil
+	}
+	return reCmd
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -27,11 +28,14 @@ import (
 	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/datastore"
 	_ "github.com/odpf/optimus/ext/datastore"
+	"github.com/odpf/optimus/ext/executor/local"
 	"github.com/odpf/optimus/ext/executor/noop"
 	"github.com/odpf/optimus/ext/notify/slack"
 	"github.com/odpf/optimus/ext/scheduler/airflow"
 	"github.com/odpf/optimus/ext/scheduler/airflow2"
 	"github.com/odpf/optimus/ext/scheduler/airflow2/compiler"
+	"github.com/odpf/optimus/ext/scheduler/argo"
+	"github.com/odpf/optimus/ext/scheduler/kubernetes"
 	"github.com/odpf/optimus/ext/scheduler/prime"
 	"github.com/odpf/optimus/job"
 	"github.com/odpf/optimus/meta"
@@ -39,6 +43,7 @@ import (
 	_ "github.com/odpf/optimus/plugin"
 	"github.com/odpf/optimus/run"
 	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/store/bucket"
 	"github.com/odpf/optimus/store/postgres"
 	"github.com/odpf/optimus/utils"
 	"github.com/odpf/salt/log"
@@ -67,6 +72,9 @@ var (
 	shutdownWait       = 30 * time.Second
 	GRPCMaxRecvMsgSize = 64 << 20 // 64MB
 	GRPCMaxSendMsgSize = 64 << 20 // 64MB
+
+	// driftCheckTimeout is the time allowed for checking drift of all namespaces
+	driftCheckTimeout = 30 * time.Minute
 )
 
 // projectJobSpecRepoFactory stores raw specifications
@@ -90,11 +98,12 @@ func (fac *replaySpecRepoRepository) New() store.ReplaySpecRepository {
 type replayWorkerFact struct {
 	replaySpecRepoFac job.ReplaySpecRepoFactory
 	scheduler         models.SchedulerUnit
+	batchConfig       job.ReplayBatchConfig
 	logger            log.Logger
 }
 
 func (fac *replayWorkerFact) New() job.ReplayWorker {
-	return job.NewReplayWorker(fac.logger, fac.replaySpecRepoFac, fac.scheduler)
+	return job.NewReplayWorker(fac.logger, fac.replaySpecRepoFac, fac.scheduler, fac.batchConfig)
 }
 
 // jobSpecRepoFactory stores raw specifications
@@ -233,6 +242,21 @@ func (o *airflowBucketFactory) New(ctx context.Context, projectSpec models.Proje
 	return nil, errors.Errorf("unsupported storage config %s", storagePath)
 }
 
+// openInstanceLogBucket opens the bucket where output of instances executed
+// by optimus cluster is stored, nil if no storage is configured
+func openInstanceLogBucket(ctx context.Context, schedulerConf config.SchedulerConfig) (*blob.Bucket, error) {
+	if schedulerConf.LogPath != "" {
+		return blob.OpenBucket(ctx, schedulerConf.LogPath)
+	}
+	if schedulerConf.DataDir == "" {
+		return nil, nil
+	}
+	return fileblob.OpenBucket(schedulerConf.DataDir, &fileblob.Options{
+		CreateDir: true,
+		Metadata:  fileblob.MetadataDontWrite,
+	})
+}
+
 type metadataServiceFactory struct {
 	writer *meta.Writer
 }
@@ -303,6 +327,25 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		return errors.Wrap(err, "postgres.Connect")
 	}
 
+	projectJobSpecRepoFac := &projectJobSpecRepoFactory{
+		db: dbConn,
+	}
+	jobrunRepoFac := &jobRunRepoFactory{
+		db: dbConn,
+	}
+	// registered job store repository factory
+	jobSpecRepoFac := jobSpecRepoFactory{
+		db:                    dbConn,
+		projectJobSpecRepoFac: *projectJobSpecRepoFac,
+	}
+	models.ManualScheduler = prime.NewScheduler(
+		jobrunRepoFac,
+		projectJobSpecRepoFac,
+		func() time.Time {
+			return time.Now().UTC()
+		},
+	)
+
 	jobCompiler := compiler.NewCompiler(conf.GetServe().IngressHost)
 	// init default scheduler
 	switch conf.GetScheduler().Name {
@@ -318,18 +361,31 @@ func Initialize(l log.Logger, conf config.Provider) error {
 			&http.Client{},
 			jobCompiler,
 		)
+	case "argo":
+		models.BatchScheduler = argo.NewScheduler(
+			&airflowBucketFactory{},
+			&http.Client{},
+			jobCompiler,
+		)
+	case "kubernetes":
+		models.BatchScheduler = kubernetes.NewScheduler(
+			&airflowBucketFactory{},
+			jobCompiler,
+			jobrunRepoFac,
+			projectJobSpecRepoFac,
+		)
+	case "sequential":
+		models.BatchScheduler = prime.NewBatchScheduler(
+			jobrunRepoFac,
+			projectJobSpecRepoFac,
+			&jobSpecRepoFac,
+			func() time.Time {
+				return time.Now().UTC()
+			},
+		)
 	default:
 		return errors.Errorf("unsupported scheduler: %s", conf.GetScheduler().Name)
 	}
-	jobrunRepoFac := &jobRunRepoFactory{
-		db: dbConn,
-	}
-	models.ManualScheduler = prime.NewScheduler(
-		jobrunRepoFac,
-		func() time.Time {
-			return time.Now().UTC()
-		},
-	)
 
 	// used to encrypt secrets
 	appHash, err := models.NewApplicationSecret(conf.GetServe().AppKey)
@@ -370,15 +426,7 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		db:   dbConn,
 		hash: appHash,
 	}
-	projectJobSpecRepoFac := &projectJobSpecRepoFactory{
-		db: dbConn,
-	}
 
-	// registered job store repository factory
-	jobSpecRepoFac := jobSpecRepoFactory{
-		db:                    dbConn,
-		projectJobSpecRepoFac: *projectJobSpecRepoFac,
-	}
 	dependencyResolver := job.NewDependencyResolver(projectJobSpecRepoFac)
 	priorityResolver := job.NewPriorityResolver()
 
@@ -442,26 +490,38 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		db:             dbConn,
 		jobSpecRepoFac: jobSpecRepoFac,
 	}
+	replayBatchConfig := job.ReplayBatchConfig{
+		Size:   conf.GetServe().ReplayBatchSize,
+		Window: conf.GetServe().ReplayBatchWindowHours,
+	}
 	replayWorkerFactory := &replayWorkerFact{
 		replaySpecRepoFac: replaySpecRepoFac,
 		scheduler:         models.BatchScheduler,
+		batchConfig:       replayBatchConfig,
 		logger:            l,
 	}
 	replayValidator := job.NewReplayValidator(models.BatchScheduler)
+	replayLocker, err := postgres.NewReplayLocker(dbConn)
+	if err != nil {
+		return errors.Wrap(err, "postgres.NewReplayLocker")
+	}
 	replaySyncer := job.NewReplaySyncer(
 		l,
 		replaySpecRepoFac,
 		projectRepoFac,
 		models.BatchScheduler,
+		replayBatchConfig,
 		func() time.Time {
 			return time.Now().UTC()
 		},
+		replayLocker,
 	)
 	replayManager := job.NewManager(l, replayWorkerFactory, replaySpecRepoFac, utils.NewUUIDProvider(), job.ReplayManagerConfig{
 		NumWorkers:    conf.GetServe().ReplayNumWorkers,
 		WorkerTimeout: conf.GetServe().ReplayWorkerTimeoutSecs,
 		RunTimeout:    conf.GetServe().ReplayRunTimeoutSecs,
-	}, models.BatchScheduler, replayValidator, replaySyncer)
+		BatchConfig:   replayBatchConfig,
+	}, models.BatchScheduler, replayValidator, replaySyncer, projectRepoFac, replayLocker)
 	backupRepoFac := backupRepoFactory{
 		db: dbConn,
 	}
@@ -477,21 +537,41 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		),
 	})
 
+	// output of instances executed by optimus cluster
+	var instanceLogRepo store.InstanceLogRepository
+	instanceLogBucket, err := openInstanceLogBucket(context.Background(), conf.GetScheduler())
+	if err != nil {
+		return errors.Wrap(err, "failed to open instance log bucket")
+	}
+	if instanceLogBucket != nil {
+		defer instanceLogBucket.Close()
+		instanceLogRepo = bucket.NewInstanceLogRepository(instanceLogBucket)
+	}
+
 	// runtime service instance over grpc
+	runService := run.NewService(
+		jobrunRepoFac,
+		func() time.Time {
+			return time.Now().UTC()
+		},
+		run.NewGoEngine(),
+		instanceLogRepo,
+	)
+	jobService := job.NewService(
+		&jobSpecRepoFac,
+		models.BatchScheduler,
+		models.ManualScheduler,
+		jobSpecAssetDump(),
+		dependencyResolver,
+		priorityResolver,
+		metaSvcFactory,
+		projectJobSpecRepoFac,
+		replayManager,
+	)
 	pb.RegisterRuntimeServiceServer(grpcServer, v1handler.NewRuntimeServiceServer(
 		l,
 		config.Version,
-		job.NewService(
-			&jobSpecRepoFac,
-			models.BatchScheduler,
-			models.ManualScheduler,
-			jobSpecAssetDump(),
-			dependencyResolver,
-			priorityResolver,
-			metaSvcFactory,
-			projectJobSpecRepoFac,
-			replayManager,
-		),
+		jobService,
 		eventService,
 		datastore.NewService(&resourceSpecRepoFac, models.DatastoreRegistry, utils.NewUUIDProvider(), &backupRepoFac),
 		projectRepoFac,
@@ -499,13 +579,7 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		projectSecretRepoFac,
 		v1.NewAdapter(models.PluginRegistry, models.DatastoreRegistry),
 		progressObs,
-		run.NewService(
-			jobrunRepoFac,
-			func() time.Time {
-				return time.Now().UTC()
-			},
-			run.NewGoEngine(),
-		),
+		runService,
 		models.BatchScheduler,
 	))
 	grpc_prometheus.Register(grpcServer)
@@ -560,14 +634,38 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		}
 	}()
 
+	var executor models.ExecutorUnit
+	switch conf.GetScheduler().Executor {
+	case "noop":
+		executor = noop.NewExecutor()
+	case "local":
+		executor = local.NewExecutor(runService, filepath.Join(conf.GetScheduler().DataDir, "executions"), local.ExecutionRetention)
+	default:
+		return errors.Errorf("unsupported executor: %s", conf.GetScheduler().Executor)
+	}
 	clusterCtx, clusterCancel := context.WithCancel(context.Background())
 	clusterServer := gossip.NewServer(l)
+	// planner materializes scheduled runs only if it is acting as the
+	// batch scheduler
+	var (
+		plannerProjectRepoFac   prime.ProjectRepoFactory
+		plannerNamespaceRepoFac prime.NamespaceRepoFactory
+		plannerJobSpecRepoFac   prime.JobSpecRepoFactory
+		plannerDepResolver      job.DependencyResolver
+	)
+	if conf.GetScheduler().Name == "sequential" {
+		plannerProjectRepoFac = projectRepoFac
+		plannerNamespaceRepoFac = namespaceSpecRepoFac
+		plannerJobSpecRepoFac = &jobSpecRepoFac
+		plannerDepResolver = dependencyResolver
+	}
 	clusterPlanner := prime.NewPlanner(
 		l,
 		clusterServer, jobrunRepoFac, &instanceRepoFactory{
 			db: dbConn,
 		},
-		utils.NewUUIDProvider(), noop.NewExecutor(), func() time.Time {
+		plannerProjectRepoFac, plannerNamespaceRepoFac, plannerJobSpecRepoFac, plannerDepResolver, runService,
+		utils.NewUUIDProvider(), executor, instanceLogRepo, func() time.Time {
 			return time.Now().UTC()
 		},
 	)
@@ -584,6 +682,17 @@ func Initialize(l log.Logger, conf config.Provider) error {
 		}
 	}
 
+	// compare deployed jobs with stored specs periodically
+	driftReconciler := job.NewDriftReconciler(l, job.DriftReconcilerConfig{
+		Interval: conf.GetServe().DriftCheckInterval,
+		Timeout:  driftCheckTimeout,
+		Repair:   conf.GetServe().DriftAutoRepair,
+	}, jobService, projectRepoFac, namespaceSpecRepoFac)
+	if err := driftReconciler.Init(); err != nil {
+		clusterCancel()
+		return err
+	}
+
 	// We'll accept graceful shutdowns when quit via SIGINT (Ctrl+C)
 	signal.Notify(termChan, os.Interrupt, os.Kill, syscall.SIGTERM)
 
@@ -595,6 +704,9 @@ func Initialize(l log.Logger, conf config.Provider) error {
 	if err = replayManager.Close(); err != nil {
 		terminalError = multierror.Append(terminalError, errors.Wrap(err, "replayManager.Close"))
 	}
+	if err = driftReconciler.Close(); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "driftReconciler.Close"))
+	}
 
 	// Create a deadline to wait for server
 	ctxProxy, cancelProxy := context.WithTimeout(context.Background(), shutdownWait)
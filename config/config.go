@@ -37,6 +37,10 @@ var (
 	KeyServeReplayNumWorkers        = "serve.replay_num_workers"
 	KeyServeReplayWorkerTimeoutSecs = "serve.replay_worker_timeout_secs"
 	KeyServeReplayRunTimeoutSecs    = "serve.replay_run_timeout_secs"
+	KeyServeReplayBatchSize         = "serve.replay_batch_size"
+	KeyServeReplayBatchWindowHours  = "serve.replay_batch_window_hours"
+	KeyServeDriftCheckInterval      = "serve.drift_check_interval"
+	KeyServeDriftAutoRepair         = "serve.drift_auto_repair"
 
 	KeySchedulerName       = "scheduler.name"
 	KeySchedulerSkipInit   = "scheduler.skip_init"
@@ -45,6 +49,9 @@ var (
 	KeySchedulerNodeID     = "scheduler.node_id"
 	KeySchedulerDataDir    = "scheduler.data_dir"
 	KeySchedulerPeers      = "scheduler.peers"
+	KeySchedulerExecutor   = "scheduler.executor"
+	KeySchedulerCapacity   = "scheduler.capacity"
+	KeySchedulerLogPath    = "scheduler.log_path"
 
 	KeyAdminEnabled = "admin.enabled"
 
@@ -118,6 +125,18 @@ type ServerConfig struct {
 	ReplayNumWorkers        int            `yaml:"replay_num_workers"`
 	ReplayWorkerTimeoutSecs time.Duration  `yaml:"replay_worker_timeout_secs"`
 	ReplayRunTimeoutSecs    time.Duration  `yaml:"replay_run_timeout_secs"`
+
+	// runs of a replay are cleared in batches of either number of runs of
+	// the replayed job or hours of schedule time, next batch is cleared once
+	// the previous one is finished. all runs are cleared at once if not set
+	ReplayBatchSize        int           `yaml:"replay_batch_size"`
+	ReplayBatchWindowHours time.Duration `yaml:"replay_batch_window_hours"`
+
+	// cron spec for how often jobs deployed on scheduler are compared with
+	// the stored specs, e.g. @every 1h, drift is not checked if empty
+	DriftCheckInterval string `yaml:"drift_check_interval"`
+	// repair the drift detected by periodic checks
+	DriftAutoRepair bool `yaml:"drift_auto_repair"`
 }
 
 type DBConfig struct {
@@ -155,6 +174,15 @@ type SchedulerConfig struct {
 	NodeID     string `yaml:"node_id"`
 	DataDir    string `yaml:"data_dir"`
 	Peers      string `yaml:"peers"`
+	Executor   string `yaml:"executor"`
+
+	// Capacity is the number of job runs this node can execute at a time
+	Capacity int `yaml:"capacity"`
+
+	// LogPath is the bucket url where output of executed instances is
+	// persisted, e.g. gs://bucket/prefix or file:///path, defaults to
+	// logs directory inside data dir
+	LogPath string `yaml:"log_path"`
 }
 
 type AdminConfig struct {
@@ -226,6 +254,10 @@ func (o *Optimus) GetServe() ServerConfig {
 		ReplayNumWorkers:        o.eKi(KeyServeReplayNumWorkers),
 		ReplayWorkerTimeoutSecs: time.Second * time.Duration(o.eKi(KeyServeReplayWorkerTimeoutSecs)),
 		ReplayRunTimeoutSecs:    time.Second * time.Duration(o.eKi(KeyServeReplayRunTimeoutSecs)),
+		ReplayBatchSize:         o.eKi(KeyServeReplayBatchSize),
+		ReplayBatchWindowHours:  time.Hour * time.Duration(o.eKi(KeyServeReplayBatchWindowHours)),
+		DriftCheckInterval:      o.eKs(KeyServeDriftCheckInterval),
+		DriftAutoRepair:         o.k.Bool(KeyServeDriftAutoRepair),
 	}
 }
 
@@ -238,6 +270,9 @@ func (o *Optimus) GetScheduler() SchedulerConfig {
 		NodeID:     o.eKs(KeySchedulerNodeID),
 		DataDir:    o.eKs(KeySchedulerDataDir),
 		Peers:      o.eKs(KeySchedulerPeers),
+		Executor:   o.eKs(KeySchedulerExecutor),
+		Capacity:   o.eKi(KeySchedulerCapacity),
+		LogPath:    o.eKs(KeySchedulerLogPath),
 	}
 }
 
@@ -55,6 +55,8 @@ func InitOptimus() (*Optimus, error) {
 		KeyServeMetadataKafkaBatchSize:  50,
 		KeyServeMetadataWriterBatchSize: 50,
 		KeySchedulerName:                "airflow2",
+		KeySchedulerExecutor:            "noop",
+		KeySchedulerCapacity:            20,
 		KeyServeReplayNumWorkers:        1,
 		KeyServeReplayWorkerTimeoutSecs: 120,
 	}, "."), nil); err != nil {
@@ -78,13 +78,55 @@ func (f *fsm) Apply(rlog *raft.Log) interface{} {
 
 					// if run at terminating states, deallocate else update
 					if patch.Status != models.RunStateFailed.String() &&
-						patch.Status != models.RunStateSuccess.String() {
+						patch.Status != models.RunStateSuccess.String() &&
+						patch.Status != models.RunStateCancelled.String() {
 						f.state.Allocation[cmdLog.PeerId].Add(jobState)
 					}
 				}
 			}
 		}
 		f.l.Debug("updated state", "alloc state", f.state.Allocation[cmdLog.PeerId])
+	case pb.CommandLog_COMMAND_TYPE_CANCEL_JOB:
+		cmdLog := &pb.CommandCancelJob{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		if _, ok := f.state.Allocation[cmdLog.PeerId]; !ok {
+			return nil
+		}
+		// mark allocated runs for cancellation, peer will stop them
+		for _, id := range cmdLog.RunIds {
+			for _, rawJobState := range f.state.Allocation[cmdLog.PeerId].Values() {
+				jobState := rawJobState.(StateJob)
+				if jobState.UUID == id {
+					f.state.Allocation[cmdLog.PeerId].Remove(jobState)
+					jobState.Status = models.RunStateCancelling.String()
+					f.state.Allocation[cmdLog.PeerId].Add(jobState)
+				}
+			}
+		}
+		f.l.Debug("updated state", "alloc state", f.state.Allocation[cmdLog.PeerId])
+	case pb.CommandLog_COMMAND_TYPE_RELEASE_JOB:
+		cmdLog := &pb.CommandReleaseJob{}
+		if err := proto.Unmarshal(cmd.Payload, cmdLog); err != nil {
+			return nil
+		}
+		if _, ok := f.state.Allocation[cmdLog.PeerId]; !ok {
+			return nil
+		}
+		// deallocate runs irrespective of their state
+		for _, id := range cmdLog.RunIds {
+			for _, rawJobState := range f.state.Allocation[cmdLog.PeerId].Values() {
+				jobState := rawJobState.(StateJob)
+				if jobState.UUID == id {
+					f.state.Allocation[cmdLog.PeerId].Remove(jobState)
+				}
+			}
+		}
+		if f.state.Allocation[cmdLog.PeerId].Size() == 0 {
+			delete(f.state.Allocation, cmdLog.PeerId)
+		}
+		f.l.Debug("updated state", "alloc state", f.state.Allocation[cmdLog.PeerId])
 	default:
 		// ignore
 	}
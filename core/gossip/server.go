@@ -30,6 +30,10 @@ const (
 	//leaderWaitDelay  = 100 * time.Millisecond
 	//appliedWaitDelay = 100 * time.Millisecond
 	//raftLogCacheSize = 512
+
+	// TagCapacity is the serf tag a peer uses to advertise how many job
+	// runs it can execute at a time
+	TagCapacity = "capacity"
 )
 
 type Server struct {
@@ -136,7 +140,7 @@ func (s *Server) IsLeader() bool {
 // if a node leaves membership gossip, it is removed from the raft cluster
 func (s *Server) initSerf(ctx context.Context, schedulerConf config.SchedulerConfig) error {
 	s.serfEvents = make(chan serf.Event)
-	serfConfig, err := newSerfConfig(schedulerConf.GossipAddr, schedulerConf.RaftAddr, schedulerConf.NodeID, s.serfEvents)
+	serfConfig, err := newSerfConfig(schedulerConf.GossipAddr, schedulerConf.RaftAddr, schedulerConf.NodeID, schedulerConf.Capacity, s.serfEvents)
 	if err != nil {
 		return err
 	}
@@ -154,7 +158,7 @@ func (s *Server) initSerf(ctx context.Context, schedulerConf config.SchedulerCon
 	return nil
 }
 
-func newSerfConfig(serfAddr, raftAddress, nodeID string, eventCh chan serf.Event) (*serf.Config, error) {
+func newSerfConfig(serfAddr, raftAddress, nodeID string, capacity int, eventCh chan serf.Event) (*serf.Config, error) {
 	serfHost, serfPort, err := net.SplitHostPort(serfAddr)
 	if err != nil {
 		return nil, err
@@ -172,6 +176,9 @@ func newSerfConfig(serfAddr, raftAddress, nodeID string, eventCh chan serf.Event
 	config.Tags = map[string]string{}
 	config.Tags["raftAddr"] = raftAddress
 	config.Tags["nodeID"] = nodeID
+	if capacity > 0 {
+		config.Tags[TagCapacity] = strconv.Itoa(capacity)
+	}
 	config.EventCh = eventCh
 	config.EnableNameConflictResolution = false
 	return config, nil
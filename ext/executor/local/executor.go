@@ -0,0 +1,350 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	// InputDirName is created inside instance directory and contains all
+	// the compiled asset files
+	InputDirName = "in"
+
+	// EnvJobDir points to the instance directory in execution environment
+	EnvJobDir = "JOB_DIR"
+
+	// logPollInterval is how often a log stream checks for more output
+	// of a running execution
+	logPollInterval = time.Millisecond * 200
+
+	// ExecutionRetention is how long a finished execution stays available
+	// for stats and logs before it is evicted along with its directory
+	ExecutionRetention = time.Minute * 10
+)
+
+// RunCompiler prepares env variables and files required to run an instance
+// of job run
+type RunCompiler interface {
+	Compile(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun,
+		instanceSpec models.InstanceSpec) (envMap map[string]string, fileMap map[string]string, err error)
+}
+
+type execution struct {
+	dir    string
+	cmd    *exec.Cmd
+	logs   *logBuffer
+	status models.JobRunState
+	state  *os.ProcessState
+
+	exitCode int
+	done     chan struct{}
+}
+
+// Executor runs task and hook entrypoints as child processes of current
+// process. Entrypoint executable of a plugin should be named after the plugin
+// and must be available in PATH
+type Executor struct {
+	compiler  RunCompiler
+	workDir   string
+	retention time.Duration
+
+	executions map[string]*execution
+	mu         *sync.Mutex
+}
+
+func (e *Executor) Start(ctx context.Context, req models.ExecutorStartRequest) (*models.ExecutorStartResponse, error) {
+	e.mu.Lock()
+	if _, ok := e.executions[req.ID]; ok {
+		e.mu.Unlock()
+		return nil, errors.Errorf("execution with id %s already exists", req.ID)
+	}
+	// reserve the id till the process is started so concurrent starts
+	// with the same id fail
+	e.executions[req.ID] = nil
+	e.mu.Unlock()
+
+	exe, err := e.start(ctx, req)
+	e.mu.Lock()
+	if err != nil {
+		delete(e.executions, req.ID)
+	} else {
+		e.executions[req.ID] = exe
+	}
+	e.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	go e.wait(req.ID, exe)
+	return &models.ExecutorStartResponse{}, nil
+}
+
+// start prepares the instance directory and starts the entrypoint in it,
+// directory is removed if the entrypoint could not be started
+func (e *Executor) start(ctx context.Context, req models.ExecutorStartRequest) (*execution, error) {
+	entrypoint, err := exec.LookPath(req.Instance.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find entrypoint of %s", req.Instance.Name)
+	}
+
+	envMap, fileMap, err := e.compiler.Compile(ctx, req.Namespace, req.JobRun, req.Instance)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compile %s of job %s", req.Instance.Name, req.Job.Name)
+	}
+
+	instanceDir := filepath.Join(e.workDir, req.ID)
+	if err := writeFiles(filepath.Join(instanceDir, InputDirName), fileMap); err != nil {
+		os.RemoveAll(instanceDir)
+		return nil, errors.Wrapf(err, "failed to prepare files of %s", req.Instance.Name)
+	}
+
+	cmd := exec.Command(entrypoint)
+	cmd.Dir = instanceDir
+	cmd.Env = os.Environ()
+	for key, value := range envMap {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", EnvJobDir, instanceDir))
+
+	logs := new(logBuffer)
+	cmd.Stdout = logs
+	cmd.Stderr = logs
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(instanceDir)
+		return nil, errors.Wrapf(err, "failed to start %s", entrypoint)
+	}
+
+	return &execution{
+		dir:    instanceDir,
+		cmd:    cmd,
+		logs:   logs,
+		status: models.RunStateRunning,
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// wait blocks till the process exits and records its final state, the
+// execution is evicted once retention period is over
+func (e *Executor) wait(id string, exe *execution) {
+	err := exe.cmd.Wait()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exe.state = exe.cmd.ProcessState
+	exe.status = models.RunStateSuccess
+	if err != nil {
+		exe.status = models.RunStateFailed
+		exe.exitCode = -1
+		if exe.state != nil && exe.state.ExitCode() != -1 {
+			exe.exitCode = exe.state.ExitCode()
+		}
+	}
+	close(exe.done)
+
+	time.AfterFunc(e.retention, func() {
+		e.evict(id)
+	})
+}
+
+func (e *Executor) Stop(ctx context.Context, req models.ExecutorStopRequest) error {
+	exe, err := e.getExecution(req.ID)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-exe.done:
+		// already finished
+		return nil
+	default:
+	}
+
+	var sig os.Signal
+	switch req.Signal {
+	case "SIGKILL":
+		sig = os.Kill
+	case "SIGINT":
+		sig = os.Interrupt
+	case "SIGTERM", "":
+		sig = syscall.SIGTERM
+	default:
+		return errors.Errorf("unsupported signal: %s", req.Signal)
+	}
+	return exe.cmd.Process.Signal(sig)
+}
+
+func (e *Executor) WaitForFinish(ctx context.Context, id string) (chan int, error) {
+	exe, err := e.getExecution(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resultChan := make(chan int, 1)
+	go func() {
+		<-exe.done
+		e.mu.Lock()
+		resultChan <- exe.exitCode
+		e.mu.Unlock()
+		close(resultChan)
+	}()
+	return resultChan, nil
+}
+
+func (e *Executor) Stats(ctx context.Context, id string) (*models.ExecutorStats, error) {
+	exe, err := e.getExecution(id)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	stats := &models.ExecutorStats{
+		Logs:     exe.logs.Bytes(),
+		Status:   exe.status.String(),
+		ExitCode: exe.exitCode,
+	}
+	if exe.state != nil {
+		stats.UserTime = exe.state.UserTime()
+		stats.SystemTime = exe.state.SystemTime()
+	}
+	return stats, nil
+}
+
+func (e *Executor) Logs(ctx context.Context, id string) (io.ReadCloser, error) {
+	exe, err := e.getExecution(id)
+	if err != nil {
+		return nil, err
+	}
+	return &logReader{
+		exe:    exe,
+		closed: make(chan struct{}),
+	}, nil
+}
+
+// evict forgets a finished execution and cleans up its directory, readers
+// holding its log stream can still read the logs till the end
+func (e *Executor) evict(id string) {
+	e.mu.Lock()
+	exe, ok := e.executions[id]
+	delete(e.executions, id)
+	e.mu.Unlock()
+	if ok {
+		os.RemoveAll(exe.dir)
+	}
+}
+
+func (e *Executor) getExecution(id string) (*execution, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exe, ok := e.executions[id]
+	if !ok || exe == nil {
+		// reserved ids are still being started
+		return nil, errors.New("invalid id, no such execution")
+	}
+	return exe, nil
+}
+
+func writeFiles(dir string, fileMap map[string]string) error {
+	if err := os.MkdirAll(dir, os.ModeDir|os.ModePerm); err != nil {
+		return err
+	}
+	for name, content := range fileMap {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logBuffer collects stdout/stderr of a process and can be read
+// while the process is still writing to it
+type logBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.buf.Bytes()...)
+}
+
+// ReadAt copies logs starting from provided offset
+func (b *logBuffer) ReadAt(p []byte, offset int) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if offset >= b.buf.Len() {
+		return 0
+	}
+	return copy(p, b.buf.Bytes()[offset:])
+}
+
+// logReader streams logs of an execution, it waits for more output
+// till the execution finishes
+type logReader struct {
+	exe    *execution
+	offset int
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (r *logReader) Read(p []byte) (int, error) {
+	for {
+		if n := r.exe.logs.ReadAt(p, r.offset); n > 0 {
+			r.offset += n
+			return n, nil
+		}
+		select {
+		case <-r.exe.done:
+			// output is fully written once process has exited
+			if n := r.exe.logs.ReadAt(p, r.offset); n > 0 {
+				r.offset += n
+				return n, nil
+			}
+			return 0, io.EOF
+		case <-r.closed:
+			return 0, io.ErrClosedPipe
+		case <-time.After(logPollInterval):
+		}
+	}
+}
+
+func (r *logReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+	return nil
+}
+
+// NewExecutor creates a local executor, each execution gets its own
+// directory inside workDir which is removed once the execution has been
+// finished for longer than retention
+func NewExecutor(compiler RunCompiler, workDir string, retention time.Duration) *Executor {
+	return &Executor{
+		compiler:   compiler,
+		workDir:    workDir,
+		retention:  retention,
+		executions: map[string]*execution{},
+		mu:         new(sync.Mutex),
+	}
+}
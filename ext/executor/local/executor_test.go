@@ -0,0 +1,297 @@
+package local_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/ext/executor/local"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutor(t *testing.T) {
+	ctx := context.Background()
+	namespaceSpec := models.NamespaceSpec{
+		Name: "ns",
+	}
+	jobSpec := models.JobSpec{
+		Name: "job-1",
+	}
+	jobRun := models.JobRun{
+		Spec: jobSpec,
+	}
+
+	// setupEntrypoint creates an executable with provided script and
+	// makes it available in PATH
+	setupEntrypoint := func(t *testing.T, name, script string) func() {
+		binDir, err := ioutil.TempDir("", "optimus-bin")
+		assert.Nil(t, err)
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(binDir, name), []byte("#!/bin/sh\n"+script), 0755))
+
+		oldPath := os.Getenv("PATH")
+		os.Setenv("PATH", binDir+string(os.PathListSeparator)+oldPath)
+		return func() {
+			os.Setenv("PATH", oldPath)
+			os.RemoveAll(binDir)
+		}
+	}
+
+	t.Run("should execute entrypoint with compiled envs and files", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-ok", `echo "value is $TASK_VALUE"
+cat $JOB_DIR/in/query.sql
+`)()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-ok", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(
+			map[string]string{"TASK_VALUE": "foo"},
+			map[string]string{"query.sql": "select 1"},
+			nil,
+		)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		_, err = executor.Start(ctx, models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		})
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, <-finishChan)
+
+		stats, err := executor.Stats(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateSuccess.String(), stats.Status)
+		assert.Equal(t, "value is foo\nselect 1", string(stats.Logs))
+	})
+	t.Run("should report exit code of failed entrypoint", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-fail", "echo failing >&2\nexit 3\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-fail", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(map[string]string{}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		_, err = executor.Start(ctx, models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		})
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, <-finishChan)
+
+		stats, err := executor.Stats(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateFailed.String(), stats.Status)
+		assert.Equal(t, 3, stats.ExitCode)
+		assert.Equal(t, "failing\n", string(stats.Logs))
+	})
+	t.Run("should stop a running entrypoint", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-sleep", "exec sleep 30\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-sleep", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(map[string]string{}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		_, err = executor.Start(ctx, models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		})
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Nil(t, executor.Stop(ctx, models.ExecutorStopRequest{ID: "exec-1", Signal: "SIGKILL"}))
+
+		select {
+		case code := <-finishChan:
+			assert.NotEqual(t, 0, code)
+		case <-time.After(time.Second * 10):
+			t.Fatal("execution was not stopped")
+		}
+		stats, err := executor.Stats(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateFailed.String(), stats.Status)
+	})
+	t.Run("should stream logs till entrypoint finishes", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-slow", "echo first\nsleep 1\necho second\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-slow", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(map[string]string{}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		_, err = executor.Start(ctx, models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		})
+		assert.Nil(t, err)
+
+		logStream, err := executor.Logs(ctx, "exec-1")
+		assert.Nil(t, err)
+		defer logStream.Close()
+
+		// reading till EOF blocks till the process exits
+		logs, err := ioutil.ReadAll(logStream)
+		assert.Nil(t, err)
+		assert.Equal(t, "first\nsecond\n", string(logs))
+
+		stats, err := executor.Stats(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateSuccess.String(), stats.Status)
+	})
+	t.Run("should evict finished execution after retention", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-evict", "echo done\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-evict", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(map[string]string{}, map[string]string{}, nil)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, time.Millisecond*100)
+		_, err = executor.Start(ctx, models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		})
+		assert.Nil(t, err)
+
+		finishChan, err := executor.WaitForFinish(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, <-finishChan)
+
+		stats, err := executor.Stats(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, "done\n", string(stats.Logs))
+
+		time.Sleep(time.Millisecond * 500)
+		_, err = executor.Stats(ctx, "exec-1")
+		assert.Equal(t, "invalid id, no such execution", err.Error())
+		_, err = os.Stat(filepath.Join(workDir, "exec-1"))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should fail to start an execution with id already in use", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-dup", "echo done\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-dup", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(map[string]string{}, map[string]string{}, nil).Once()
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		req := models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		}
+		_, err = executor.Start(ctx, req)
+		assert.Nil(t, err)
+		_, err = executor.Start(ctx, req)
+		assert.Equal(t, "execution with id exec-1 already exists", err.Error())
+
+		finishChan, err := executor.WaitForFinish(ctx, "exec-1")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, <-finishChan)
+	})
+	t.Run("should clean up instance directory if files could not be prepared", func(t *testing.T) {
+		defer setupEntrypoint(t, "local-task-files", "echo done\n")()
+		workDir, err := ioutil.TempDir("", "optimus-work")
+		assert.Nil(t, err)
+		defer os.RemoveAll(workDir)
+
+		instance := models.InstanceSpec{Name: "local-task-files", Type: models.InstanceTypeTask}
+		compiler := new(mock.RunService)
+		compiler.On("Compile", ctx, namespaceSpec, jobRun, instance).Return(
+			map[string]string{},
+			map[string]string{"missing/query.sql": "select 1"},
+			nil,
+		)
+		defer compiler.AssertExpectations(t)
+
+		executor := local.NewExecutor(compiler, workDir, local.ExecutionRetention)
+		req := models.ExecutorStartRequest{
+			ID:        "exec-1",
+			Job:       jobSpec,
+			Namespace: namespaceSpec,
+			JobRun:    jobRun,
+			Instance:  instance,
+		}
+		_, err = executor.Start(ctx, req)
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to prepare files of local-task-files"))
+		_, err = os.Stat(filepath.Join(workDir, "exec-1"))
+		assert.True(t, os.IsNotExist(err))
+
+		// id is released for a retry
+		_, err = executor.Start(ctx, req)
+		assert.NotEqual(t, "execution with id exec-1 already exists", err.Error())
+	})
+	t.Run("should fail if entrypoint is not found", func(t *testing.T) {
+		executor := local.NewExecutor(new(mock.RunService), os.TempDir(), local.ExecutionRetention)
+		_, err := executor.Start(ctx, models.ExecutorStartRequest{
+			ID:       "exec-1",
+			Instance: models.InstanceSpec{Name: "local-task-missing"},
+		})
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to find entrypoint of local-task-missing"))
+	})
+	t.Run("should fail for unknown execution ids", func(t *testing.T) {
+		executor := local.NewExecutor(new(mock.RunService), os.TempDir(), local.ExecutionRetention)
+		_, err := executor.Stats(ctx, "unknown")
+		assert.Equal(t, "invalid id, no such execution", err.Error())
+		_, err = executor.WaitForFinish(ctx, "unknown")
+		assert.Equal(t, "invalid id, no such execution", err.Error())
+		err = executor.Stop(ctx, models.ExecutorStopRequest{ID: "unknown"})
+		assert.Equal(t, "invalid id, no such execution", err.Error())
+		_, err = executor.Logs(ctx, "unknown")
+		assert.Equal(t, "invalid id, no such execution", err.Error())
+	})
+}
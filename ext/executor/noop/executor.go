@@ -2,6 +2,9 @@ package noop
 
 import (
 	"context"
+	"io"
+	"io/ioutil"
+	"strings"
 	"sync"
 	"time"
 
@@ -72,3 +75,14 @@ func (e *Executor) Stats(ctx context.Context, id string) (*models.ExecutorStats,
 		Status: e.state[id].String(),
 	}, nil
 }
+
+func (e *Executor) Logs(ctx context.Context, id string) (io.ReadCloser, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.state[id]; !ok {
+		return nil, errors.New("invalid id, no such execution")
+	}
+
+	// nothing gets executed, so nothing to log
+	return ioutil.NopCloser(strings.NewReader("")), nil
+}
@@ -1,6 +1,7 @@
 package airflow
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -36,6 +37,8 @@ const (
 	baseLibFileName = "__lib.py"
 	dagStatusURL    = "api/experimental/dags/%s/dag_runs"
 	dagRunClearURL  = "clear&dag_id=%s&start_date=%s&end_date=%s"
+	dagPausedURL    = "api/experimental/dags/%s/paused/%t"
+	dagRunCreateURL = "api/experimental/dags/%s/dag_runs"
 
 	JobsDir       = "dags"
 	JobsExtension = ".py"
@@ -76,6 +79,10 @@ func (s *scheduler) Bootstrap(ctx context.Context, proj models.ProjectSpec) erro
 	return bucket.WriteAll(ctx, filepath.Join(JobsDir, baseLibFileName), SharedLib, nil)
 }
 
+func (s *scheduler) CompileJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) (models.Job, error) {
+	return s.compiler.Compile(s.GetTemplate(), namespace, job)
+}
+
 func (s *scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) error {
 	_, err := s.compiler.Compile(s.GetTemplate(), namespace, job)
 	return err
@@ -321,6 +328,76 @@ func (a *scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.Proj
 	return requestedJobStatus, nil
 }
 
+func (a *scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return a.setPaused(ctx, namespace.ProjectSpec, jobName, true)
+}
+
+func (a *scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return a.setPaused(ctx, namespace.ProjectSpec, jobName, false)
+}
+
+func (a *scheduler) setPaused(ctx context.Context, projSpec models.ProjectSpec, jobName string, paused bool) error {
+	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
+	if !ok {
+		return errors.Errorf("scheduler host not set for %s", projSpec.Name)
+	}
+	schdHost = strings.Trim(schdHost, "/")
+
+	pausedURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, dagPausedURL), jobName, paused)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, pausedURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", pausedURL)
+	}
+
+	resp, err := a.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update airflow dag from %s", pausedURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to update airflow dag from %s: %d", pausedURL, resp.StatusCode)
+	}
+	return nil
+}
+
+// TriggerRun creates a dag run with provided execution date using the
+// experimental api of airflow
+func (a *scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	schdHost, ok := namespace.ProjectSpec.Config[models.ProjectSchedulerHost]
+	if !ok {
+		return errors.Errorf("scheduler host not set for %s", namespace.ProjectSpec.Name)
+	}
+	schdHost = strings.Trim(schdHost, "/")
+
+	if config == nil {
+		config = map[string]string{}
+	}
+	jsonStr, err := json.Marshal(map[string]interface{}{
+		"execution_date": scheduledAt.UTC().Format(time.RFC3339),
+		"conf":           config,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to build run configuration of %s", jobName)
+	}
+	postURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, dagRunCreateURL), jobName)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", postURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to trigger airflow dag run from %s", postURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to trigger airflow dag run from %s: %d", postURL, resp.StatusCode)
+	}
+	return nil
+}
+
 func (s *scheduler) notifyProgress(po progress.Observer, event progress.Event) {
 	if po == nil {
 		return
@@ -70,6 +70,14 @@ transformation_bq = SuperKubernetesPodOperator(
         "SCHEDULED_AT":'{{ next_execution_date }}',
     },
 
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources={
+        "request_cpu": "250m",
+        "request_memory": "128Mi",
+        "limit_memory": "1Gi",
+    },
     reattach_on_restart=True
 )
 
@@ -103,6 +111,14 @@ hook_transporter = SuperKubernetesPodOperator(
         "SCHEDULED_AT":'{{ next_execution_date }}',
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     },
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources={
+        "request_cpu": "250m",
+        "request_memory": "128Mi",
+        "limit_memory": "1Gi",
+    },
     reattach_on_restart=True
 )
 
@@ -128,6 +144,14 @@ hook_predator = SuperKubernetesPodOperator(
         "SCHEDULED_AT":'{{ next_execution_date }}',
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     },
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources={
+        "request_cpu": "250m",
+        "request_memory": "128Mi",
+        "limit_memory": "1Gi",
+    },
     reattach_on_restart=True
 )
 
@@ -153,6 +177,14 @@ hook_hook__dash__for__dash__fail = SuperKubernetesPodOperator(
         "SCHEDULED_AT":'{{ next_execution_date }}',
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     },
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources={
+        "request_cpu": "250m",
+        "request_memory": "128Mi",
+        "limit_memory": "1Gi",
+    },
     trigger_rule="one_failed",
     reattach_on_restart=True
 )
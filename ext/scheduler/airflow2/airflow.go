@@ -3,12 +3,14 @@ package airflow2
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"path"
 	"path/filepath"
 	"strings"
@@ -43,11 +45,25 @@ const (
 	dagStatusUrl      = "api/v1/dags/%s/dagRuns?limit=99999"
 	dagStatusBatchUrl = "api/v1/dags/~/dagRuns/list"
 	dagRunClearURL    = "api/v1/dags/%s/clearTaskInstances"
+	dagPauseURL       = "api/v1/dags/%s?update_mask=is_paused"
+	dagRunCreateURL   = "api/v1/dags/%s/dagRuns"
+	dagRunUpdateURL   = "api/v1/dags/%s/dagRuns/%s"
+	taskInstancesURL  = "api/v1/dags/%s/dagRuns/%s/taskInstances?limit=%d"
 	airflowDateFormat = "2006-01-02T15:04:05+00:00"
 
 	JobsDir       = "dags"
 	JobsExtension = ".py"
 
+	// airflow caps page size of task instances to its maximum page limit
+	// which defaults to 100, jobs have way fewer tasks than that
+	taskInstancesPageLimit = 100
+
+	// page size used while looking up dag runs to be cancelled
+	dagRunsPageLimit = 100
+
+	sensorTaskPrefix = "wait_"
+	hookTaskPrefix   = "hook_"
+
 	ConcurrentTicketPerSec = 40
 	ConcurrentLimit        = 600
 )
@@ -82,17 +98,35 @@ func (s *scheduler) GetTemplate() []byte {
 	return resBaseDAG
 }
 
+// GetProjectTemplate returns the custom dag template of project if it has
+// registered one, default template otherwise
+func (s *scheduler) GetProjectTemplate(projectSpec models.ProjectSpec) []byte {
+	if len(projectSpec.JobTemplate) > 0 {
+		return projectSpec.JobTemplate
+	}
+	return s.GetTemplate()
+}
+
+// Bootstrap uploads the shared library used by dags and syncs secrets
+// selected by the project as airflow connections and variables
 func (s *scheduler) Bootstrap(ctx context.Context, proj models.ProjectSpec) error {
 	bucket, err := s.bucketFac.New(ctx, proj)
 	if err != nil {
 		return err
 	}
 	defer bucket.Close()
-	return bucket.WriteAll(ctx, filepath.Join(JobsDir, baseLibFileName), SharedLib, nil)
+	if err := bucket.WriteAll(ctx, filepath.Join(JobsDir, baseLibFileName), SharedLib, nil); err != nil {
+		return err
+	}
+	return s.syncSecrets(ctx, proj)
+}
+
+func (s *scheduler) CompileJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) (models.Job, error) {
+	return s.compiler.Compile(s.GetProjectTemplate(namespace.ProjectSpec), namespace, job)
 }
 
 func (s *scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) error {
-	_, err := s.compiler.Compile(s.GetTemplate(), namespace, job)
+	_, err := s.compiler.Compile(s.GetProjectTemplate(namespace.ProjectSpec), namespace, job)
 	return err
 }
 
@@ -104,11 +138,17 @@ func (s *scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSp
 	}
 	defer bucket.Close()
 
+	// dags which are already uploaded with the same content are skipped
+	deployedHashes, err := s.listJobHashes(ctx, bucket, namespace)
+	if err != nil {
+		return errors.Wrap(err, "failed to list deployed dags")
+	}
+
 	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
 	for _, j := range jobs {
 		runner.Add(func(currentJobSpec models.JobSpec) func() (interface{}, error) {
 			return func() (interface{}, error) {
-				compiledJob, err := s.compiler.Compile(s.GetTemplate(), namespace, currentJobSpec)
+				compiledJob, err := s.compiler.Compile(s.GetProjectTemplate(namespace.ProjectSpec), namespace, currentJobSpec)
 				if err != nil {
 					return nil, err
 				}
@@ -117,6 +157,14 @@ func (s *scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSp
 				})
 
 				blobKey := PathFromJobName(JobsDir, namespace.ID.String(), compiledJob.Name, JobsExtension)
+				contentHash := md5.Sum(compiledJob.Contents)
+				if deployedHash, ok := deployedHashes[blobKey]; ok && bytes.Equal(deployedHash, contentHash[:]) {
+					s.notifyProgress(progressObserver, &models.EventJobUpload{
+						Name:    compiledJob.Name,
+						Skipped: true,
+					})
+					return true, nil
+				}
 				if err := bucket.WriteAll(ctx, blobKey, compiledJob.Contents, nil); err != nil {
 					s.notifyProgress(progressObserver, &models.EventJobUpload{
 						Name: compiledJob.Name,
@@ -128,18 +176,50 @@ func (s *scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSp
 					Name: compiledJob.Name,
 					Err:  nil,
 				})
-				return nil, nil
+				return false, nil
 			}
 		}(j))
 	}
+	skipped := 0
 	for _, result := range runner.Run() {
 		if result.Err != nil {
 			err = multierror.Append(err, result.Err)
+			continue
+		}
+		if result.Val.(bool) {
+			skipped++
 		}
 	}
+	if skipped > 0 {
+		s.notifyProgress(progressObserver, &models.EventJobUploadSkipped{
+			Count: skipped,
+		})
+	}
 	return err
 }
 
+// listJobHashes returns md5 hash of dags uploaded for the namespace, keyed
+// by their path in bucket. Dags are missing if storage doesn't report hash
+func (s *scheduler) listJobHashes(ctx context.Context, bucket Bucket, namespace models.NamespaceSpec) (map[string][]byte, error) {
+	hashes := map[string][]byte{}
+	it := bucket.List(&blob.ListOptions{
+		Prefix: PathForJobDirectory(JobsDir, namespace.ID.String()),
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if strings.HasSuffix(obj.Key, JobsExtension) && len(obj.MD5) > 0 {
+			hashes[obj.Key] = obj.MD5
+		}
+	}
+	return hashes, nil
+}
+
 func (s *scheduler) DeleteJobs(ctx context.Context, namespace models.NamespaceSpec, jobNames []string,
 	progressObserver progress.Observer) error {
 	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
@@ -291,6 +371,33 @@ func (s *scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobN
 	return nil
 }
 
+// CancelRuns marks dag runs of the job between start and end date which are
+// still queued or running as failed, airflow stops their running tasks
+func (s *scheduler) CancelRuns(ctx context.Context, projSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
+	schdHost, authToken, err := s.getHostAuth(projSpec)
+	if err != nil {
+		return err
+	}
+	schdHost = strings.Trim(schdHost, "/")
+
+	runs, err := s.listUnfinishedDagRuns(ctx, schdHost, authToken, jobName, startDate, endDate)
+	if err != nil {
+		return err
+	}
+	payload := []byte(`{"state":"failed"}`)
+	for _, run := range runs {
+		patchURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, dagRunUpdateURL), jobName, url.PathEscape(run.ID))
+		statusCode, err := s.sendJSON(ctx, http.MethodPatch, patchURL, authToken, payload)
+		if err != nil {
+			return err
+		}
+		if statusCode != http.StatusOK {
+			return errors.Errorf("failed to cancel airflow dag run from %s: %d", patchURL, statusCode)
+		}
+	}
+	return nil
+}
+
 func (s *scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time,
 	endDate time.Time, batchSize int) ([]models.JobStatus, error) {
 	schdHost, authToken, err := s.getHostAuth(projectSpec)
@@ -357,6 +464,146 @@ func (s *scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.Proj
 	return jobStatus, nil
 }
 
+// GetJobRunDetails finds the dag run scheduled at provided time and fetches
+// details of all of its task instances
+func (s *scheduler) GetJobRunDetails(ctx context.Context, projectSpec models.ProjectSpec, jobName string,
+	scheduledAt time.Time) (models.JobRunDetails, error) {
+	schdHost, authToken, err := s.getHostAuth(projectSpec)
+	if err != nil {
+		return models.JobRunDetails{}, err
+	}
+	schdHost = strings.Trim(schdHost, "/")
+
+	run, err := s.findDagRun(ctx, schdHost, authToken, jobName, scheduledAt)
+	if err != nil {
+		return models.JobRunDetails{}, err
+	}
+
+	getURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, taskInstancesURL),
+		jobName, url.PathEscape(run.ID), taskInstancesPageLimit)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
+	if err != nil {
+		return models.JobRunDetails{}, errors.Wrapf(err, "failed to build http request for %s", getURL)
+	}
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return models.JobRunDetails{}, errors.Wrapf(err, "failed to fetch airflow task instances from %s", getURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return models.JobRunDetails{}, errors.Errorf("failed to fetch airflow task instances from %s: %d", getURL, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return models.JobRunDetails{}, errors.Wrap(err, "failed to read airflow response")
+	}
+	var responseJSON struct {
+		TaskInstances []taskInstance `json:"task_instances"`
+	}
+	if err := json.Unmarshal(body, &responseJSON); err != nil {
+		return models.JobRunDetails{}, errors.Wrapf(err, "json error: %s", string(body))
+	}
+
+	runDetails := models.JobRunDetails{
+		ScheduledAt: scheduledAt,
+		State:       models.JobRunState(run.State),
+	}
+	for _, ti := range responseJSON.TaskInstances {
+		instance, err := ti.toInstanceDetails()
+		if err != nil {
+			return models.JobRunDetails{}, errors.Wrapf(err, "failed to read task instance %s of %s", ti.TaskID, jobName)
+		}
+		runDetails.Instances = append(runDetails.Instances, instance)
+	}
+	return runDetails, nil
+}
+
+func (s *scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return s.setPaused(ctx, namespace.ProjectSpec, jobName, true)
+}
+
+func (s *scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return s.setPaused(ctx, namespace.ProjectSpec, jobName, false)
+}
+
+// setPaused toggles the paused flag of dag, airflow doesn't schedule new
+// dag runs of a paused dag but lets the running ones finish
+func (s *scheduler) setPaused(ctx context.Context, projSpec models.ProjectSpec, jobName string, paused bool) error {
+	schdHost, authToken, err := s.getHostAuth(projSpec)
+	if err != nil {
+		return err
+	}
+
+	schdHost = strings.Trim(schdHost, "/")
+	var jsonStr = []byte(fmt.Sprintf(`{"is_paused": %t}`, paused))
+	patchURL := fmt.Sprintf(
+		fmt.Sprintf("%s/%s", schdHost, dagPauseURL),
+		jobName)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPatch, patchURL, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", patchURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update airflow dag from %s", patchURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to update airflow dag from %s: %d", patchURL, resp.StatusCode)
+	}
+	return nil
+}
+
+// TriggerRun creates a dag run with provided execution date, airflow fails
+// the request if a run already exists for the same date
+func (s *scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	schdHost, authToken, err := s.getHostAuth(namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+
+	schdHost = strings.Trim(schdHost, "/")
+	if config == nil {
+		config = map[string]string{}
+	}
+	jsonStr, err := json.Marshal(map[string]interface{}{
+		"execution_date": scheduledAt.UTC().Format(airflowDateFormat),
+		"conf":           config,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to build run configuration of %s", jobName)
+	}
+	postURL := fmt.Sprintf(
+		fmt.Sprintf("%s/%s", schdHost, dagRunCreateURL),
+		jobName)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", postURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to trigger airflow dag run from %s", postURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to trigger airflow dag run from %s: %d", postURL, resp.StatusCode)
+	}
+	return nil
+}
+
 func (s *scheduler) getHostAuth(projectSpec models.ProjectSpec) (string, string, error) {
 	schdHost, ok := projectSpec.Config[models.ProjectSchedulerHost]
 	if !ok {
@@ -396,6 +643,159 @@ func toJobStatus(dagRuns []map[string]interface{}, jobName string) ([]models.Job
 	return jobStatus, nil
 }
 
+// findDagRun looks up the dag run of a job by its execution date
+func (s *scheduler) findDagRun(ctx context.Context, schdHost, authToken, jobName string, scheduledAt time.Time) (dagRun, error) {
+	postURL := fmt.Sprintf("%s/%s", schdHost, dagStatusBatchUrl)
+	jsonStr, err := json.Marshal(map[string]interface{}{
+		"page_limit":         1,
+		"dag_ids":            []string{jobName},
+		"execution_date_gte": scheduledAt.UTC().Format(airflowDateFormat),
+		"execution_date_lte": scheduledAt.UTC().Format(airflowDateFormat),
+	})
+	if err != nil {
+		return dagRun{}, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		return dagRun{}, errors.Wrapf(err, "failed to build http request for %s", postURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return dagRun{}, errors.Wrapf(err, "failed to fetch airflow dag runs from %s", postURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return dagRun{}, errors.Errorf("failed to fetch airflow dag runs from %s: %d", postURL, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return dagRun{}, errors.Wrap(err, "failed to read airflow response")
+	}
+	var responseJSON struct {
+		DagRuns []dagRun `json:"dag_runs"`
+	}
+	if err := json.Unmarshal(body, &responseJSON); err != nil {
+		return dagRun{}, errors.Wrapf(err, "json error: %s", string(body))
+	}
+	if len(responseJSON.DagRuns) == 0 {
+		return dagRun{}, errors.Errorf("no run of %s found scheduled at %s", jobName,
+			scheduledAt.UTC().Format(airflowDateFormat))
+	}
+	return responseJSON.DagRuns[0], nil
+}
+
+// listUnfinishedDagRuns fetches queued and running dag runs of a job between
+// start and end date, all pages are read before any run gets updated
+func (s *scheduler) listUnfinishedDagRuns(ctx context.Context, schdHost, authToken, jobName string,
+	startDate, endDate time.Time) ([]dagRun, error) {
+	postURL := fmt.Sprintf("%s/%s", schdHost, dagStatusBatchUrl)
+
+	var runs []dagRun
+	for pageOffset := 0; ; pageOffset += dagRunsPageLimit {
+		payload, err := json.Marshal(map[string]interface{}{
+			"page_offset":        pageOffset,
+			"page_limit":         dagRunsPageLimit,
+			"dag_ids":            []string{jobName},
+			"states":             []string{"queued", "running"},
+			"execution_date_gte": startDate.UTC().Format(airflowDateFormat),
+			"execution_date_lte": endDate.UTC().Format(airflowDateFormat),
+		})
+		if err != nil {
+			return nil, err
+		}
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(payload))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build http request for %s", postURL)
+		}
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+		resp, err := s.httpClient.Do(request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch airflow dag runs from %s", postURL)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("failed to fetch airflow dag runs from %s: %d", postURL, resp.StatusCode)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read airflow response")
+		}
+
+		var responseJSON struct {
+			DagRuns      []dagRun `json:"dag_runs"`
+			TotalEntries int      `json:"total_entries"`
+		}
+		if err := json.Unmarshal(body, &responseJSON); err != nil {
+			return nil, errors.Wrapf(err, "json error: %s", string(body))
+		}
+		runs = append(runs, responseJSON.DagRuns...)
+		if responseJSON.TotalEntries <= pageOffset+dagRunsPageLimit {
+			break
+		}
+	}
+	return runs, nil
+}
+
+type dagRun struct {
+	ID    string `json:"dag_run_id"`
+	State string `json:"state"`
+}
+
+// taskInstance is a task instance as returned by airflow rest api, fields
+// are nil till the instance gets scheduled
+type taskInstance struct {
+	TaskID    string   `json:"task_id"`
+	State     *string  `json:"state"`
+	TryNumber int      `json:"try_number"`
+	StartDate *string  `json:"start_date"`
+	EndDate   *string  `json:"end_date"`
+	Duration  *float64 `json:"duration"`
+}
+
+func (ti taskInstance) toInstanceDetails() (models.InstanceDetails, error) {
+	instance := models.InstanceDetails{
+		Name:      ti.TaskID,
+		Type:      models.InstanceTypeTask,
+		TryNumber: ti.TryNumber,
+	}
+	// task ids are generated as per the dag template
+	switch {
+	case strings.HasPrefix(ti.TaskID, sensorTaskPrefix):
+		instance.Name = strings.TrimPrefix(ti.TaskID, sensorTaskPrefix)
+		instance.Type = models.InstanceTypeSensor
+	case strings.HasPrefix(ti.TaskID, hookTaskPrefix):
+		instance.Name = strings.TrimPrefix(ti.TaskID, hookTaskPrefix)
+		instance.Type = models.InstanceTypeHook
+	}
+	if ti.State != nil {
+		instance.State = models.JobRunState(*ti.State)
+	}
+	if ti.StartDate != nil {
+		startedAt, err := time.Parse(models.InstanceScheduledAtTimeLayout, *ti.StartDate)
+		if err != nil {
+			return instance, errors.Wrapf(err, "invalid start date %s", *ti.StartDate)
+		}
+		instance.StartedAt = startedAt.UTC()
+	}
+	if ti.EndDate != nil {
+		endedAt, err := time.Parse(models.InstanceScheduledAtTimeLayout, *ti.EndDate)
+		if err != nil {
+			return instance, errors.Wrapf(err, "invalid end date %s", *ti.EndDate)
+		}
+		instance.EndedAt = endedAt.UTC()
+	}
+	if ti.Duration != nil {
+		instance.Duration = time.Duration(*ti.Duration * float64(time.Second))
+	}
+	return instance, nil
+}
+
 func PathForJobDirectory(prefix, namespace string) string {
 	if len(prefix) > 0 && prefix[0] == '/' {
 		prefix = prefix[1:]
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,11 +22,23 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/ext/scheduler/airflow2"
 	"github.com/odpf/optimus/models"
 	"github.com/stretchr/testify/assert"
 )
 
+type recordingObserver struct {
+	mu     sync.Mutex
+	events []progress.Event
+}
+
+func (obs *recordingObserver) Notify(evt progress.Event) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	obs.events = append(obs.events, evt)
+}
+
 type MockHttpClient struct {
 	DoFunc func(req *http.Request) (*http.Response, error)
 }
@@ -132,6 +145,162 @@ func TestAirflow2(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, airflow2.SharedLib, storedBytes)
 		})
+		t.Run("should sync secrets selected by the project", func(t *testing.T) {
+			syncProj := models.ProjectSpec{
+				Name: "proj-name",
+				Config: map[string]string{
+					models.ProjectSchedulerHost:     "http://airflow.example.io",
+					airflow2.SyncVariablesConfigKey: "api_token,missing_token",
+				},
+				Secret: []models.ProjectSecretItem{
+					{
+						Name:  models.ProjectSchedulerAuth,
+						Value: "admin:admin",
+					},
+					{
+						Name:  "api_token",
+						Value: "secret-token",
+					},
+				},
+			}
+			mockBucket := &MockedBucket{
+				bucket: memblob.OpenBucket(nil),
+			}
+			mockBucket.On("WriteAll", ctx, "dags/__lib.py", airflow2.SharedLib, (*blob.WriterOptions)(nil)).Return(nil)
+			defer mockBucket.AssertExpectations(t)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, syncProj).Return(mockBucket, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			var requests []string
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requests = append(requests, req.Method+" "+req.URL.String())
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(mockBucketFac, client, nil)
+			err := air.Bootstrap(ctx, syncProj)
+			assert.Contains(t, err.Error(), "secret missing_token selected for syncing is not registered in project proj-name")
+			assert.Equal(t, []string{"PATCH http://airflow.example.io/api/v1/variables/api_token"}, requests)
+		})
+	})
+	t.Run("SyncSecret", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		projSpec := models.ProjectSpec{
+			Name: "test-proj",
+			Config: map[string]string{
+				models.ProjectSchedulerHost:       host,
+				airflow2.SyncConnectionsConfigKey: "bq_conn, pg_conn",
+				airflow2.SyncVariablesConfigKey:   "api_token",
+			},
+			Secret: []models.ProjectSecretItem{
+				{
+					Name:  models.ProjectSchedulerAuth,
+					Value: "admin:admin",
+				},
+			},
+		}
+
+		t.Run("should create connection if it doesn't exist in airflow", func(t *testing.T) {
+			var requests []string
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requests = append(requests, req.Method+" "+req.URL.String())
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+					assert.JSONEq(t, `{"connection_id": "pg_conn", "conn_type": "postgres", "host": "db.example.io", "port": 5432}`, string(body))
+
+					statusCode := http.StatusOK
+					if req.Method == http.MethodPatch {
+						statusCode = http.StatusNotFound
+					}
+					return &http.Response{
+						StatusCode: statusCode,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.SyncSecret(ctx, projSpec, models.ProjectSecretItem{
+				Name:  "pg_conn",
+				Value: `{"conn_type": "postgres", "host": "db.example.io", "port": 5432}`,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{
+				"PATCH " + host + "/api/v1/connections/pg_conn",
+				"POST " + host + "/api/v1/connections",
+			}, requests)
+		})
+		t.Run("should update existing variable in airflow", func(t *testing.T) {
+			var requests []string
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requests = append(requests, req.Method+" "+req.URL.String())
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+					assert.JSONEq(t, `{"key": "api_token", "value": "secret-token"}`, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.SyncSecret(ctx, projSpec, models.ProjectSecretItem{
+				Name:  "api_token",
+				Value: "secret-token",
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"PATCH " + host + "/api/v1/variables/api_token"}, requests)
+		})
+		t.Run("should ignore secrets not selected for syncing", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.String())
+					return nil, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.SyncSecret(ctx, projSpec, models.ProjectSecretItem{
+				Name:  "other_secret",
+				Value: "value",
+			})
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if connection secret is not a json of connection fields", func(t *testing.T) {
+			air := airflow2.NewScheduler(nil, &MockHttpClient{}, nil)
+			err := air.SyncSecret(ctx, projSpec, models.ProjectSecretItem{
+				Name:  "bq_conn",
+				Value: "not-a-json",
+			})
+			assert.Equal(t, "secret bq_conn synced as airflow connection should be a json of connection fields", err.Error())
+		})
+		t.Run("should fail if airflow fails to save the secret", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusForbidden,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.SyncSecret(ctx, projSpec, models.ProjectSecretItem{
+				Name:  "api_token",
+				Value: "secret-token",
+			})
+			assert.Equal(t, "failed to sync api_token with airflow at http://airflow.example.io: 403", err.Error())
+		})
 	})
 	t.Run("DeployJobs", func(t *testing.T) {
 		t.Run("should successfully deploy jobs to blob buckets", func(t *testing.T) {
@@ -154,6 +323,7 @@ func TestAirflow2(t *testing.T) {
 				Contents: []byte("job-1-compiled"),
 			}, nil)
 
+			mockBucket.On("List", &blob.ListOptions{Prefix: fmt.Sprintf("dags/%s", nsUUID)})
 			mockBucket.On("WriteAll", ctx, fmt.Sprintf("dags/%s/%s.py", nsUUID, jobSpecs[0].Name), []byte("job-1-compiled"), (*blob.WriterOptions)(nil)).Return(nil)
 			err := air.DeployJobs(ctx, ns, jobSpecs, nil)
 			assert.Nil(t, err)
@@ -162,6 +332,74 @@ func TestAirflow2(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, []byte("job-1-compiled"), storedBytes)
 		})
+		t.Run("should skip uploading dags which are unchanged", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+			_ = inMemBlob.WriteAll(ctx, fmt.Sprintf("dags/%s/job-1.py", nsUUID), []byte("job-1-compiled"), nil)
+			_ = inMemBlob.WriteAll(ctx, fmt.Sprintf("dags/%s/job-2.py", nsUUID), []byte("job-2-compiled"), nil)
+			mockBucket := &MockedBucket{
+				bucket: inMemBlob,
+			}
+			defer mockBucket.AssertExpectations(t)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, proj).Return(mockBucket, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			jobSpecs := []models.JobSpec{{Name: "job-1"}, {Name: "job-2"}}
+			compiler := new(MockedCompiler)
+			air := airflow2.NewScheduler(mockBucketFac, nil, compiler)
+			defer compiler.AssertExpectations(t)
+
+			compiler.On("Compile", air.GetTemplate(), ns, jobSpecs[0]).Return(models.Job{
+				Name:     jobSpecs[0].Name,
+				Contents: []byte("job-1-compiled"),
+			}, nil)
+			compiler.On("Compile", air.GetTemplate(), ns, jobSpecs[1]).Return(models.Job{
+				Name:     jobSpecs[1].Name,
+				Contents: []byte("job-2-changed"),
+			}, nil)
+
+			mockBucket.On("List", &blob.ListOptions{Prefix: fmt.Sprintf("dags/%s", nsUUID)})
+			mockBucket.On("WriteAll", ctx, fmt.Sprintf("dags/%s/job-2.py", nsUUID), []byte("job-2-changed"), (*blob.WriterOptions)(nil)).Return(nil)
+			observer := &recordingObserver{}
+			err := air.DeployJobs(ctx, ns, jobSpecs, observer)
+			assert.Nil(t, err)
+
+			storedBytes, err := inMemBlob.ReadAll(ctx, fmt.Sprintf("dags/%s/job-2.py", nsUUID))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("job-2-changed"), storedBytes)
+			assert.Contains(t, observer.events, &models.EventJobUpload{Name: "job-1", Skipped: true})
+			assert.Contains(t, observer.events, &models.EventJobUpload{Name: "job-2"})
+			assert.Equal(t, &models.EventJobUploadSkipped{Count: 1}, observer.events[len(observer.events)-1])
+		})
+		t.Run("should compile jobs using job template of the project if set", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+			mockBucket := &MockedBucket{
+				bucket: inMemBlob,
+			}
+			defer mockBucket.AssertExpectations(t)
+
+			customNs := ns
+			customNs.ProjectSpec.JobTemplate = []byte("custom-template")
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, customNs.ProjectSpec).Return(mockBucket, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			compiler := new(MockedCompiler)
+			air := airflow2.NewScheduler(mockBucketFac, nil, compiler)
+			defer compiler.AssertExpectations(t)
+
+			compiler.On("Compile", []byte("custom-template"), customNs, jobSpecs[0]).Return(models.Job{
+				Name:     jobSpecs[0].Name,
+				Contents: []byte("job-1-compiled"),
+			}, nil)
+
+			mockBucket.On("List", &blob.ListOptions{Prefix: fmt.Sprintf("dags/%s", nsUUID)})
+			mockBucket.On("WriteAll", ctx, fmt.Sprintf("dags/%s/%s.py", nsUUID, jobSpecs[0].Name), []byte("job-1-compiled"), (*blob.WriterOptions)(nil)).Return(nil)
+			err := air.DeployJobs(ctx, customNs, jobSpecs, nil)
+			assert.Nil(t, err)
+		})
 	})
 	t.Run("DeleteJobs", func(t *testing.T) {
 		t.Run("should successfully delete jobs from blob buckets", func(t *testing.T) {
@@ -446,6 +684,333 @@ func TestAirflow2(t *testing.T) {
 			assert.NotNil(t, err)
 		})
 	})
+	t.Run("CancelRuns", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		startDateTime := time.Date(2021, 5, 20, 2, 0, 0, 0, time.UTC)
+		endDateTime := time.Date(2021, 5, 25, 2, 0, 0, 0, time.UTC)
+		projSpec := models.ProjectSpec{
+			Name: "test-proj",
+			Config: map[string]string{
+				models.ProjectSchedulerHost: host,
+			},
+			Secret: []models.ProjectSecretItem{
+				{
+					Name:  models.ProjectSchedulerAuth,
+					Value: "admin:admin",
+				},
+			},
+		}
+
+		t.Run("should mark queued and running dag runs as failed", func(t *testing.T) {
+			var requests []string
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requests = append(requests, req.Method+" "+req.URL.String())
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+
+					respBody := "{}"
+					if req.Method == http.MethodPost {
+						assert.JSONEq(t, `{
+							"page_offset": 0,
+							"page_limit": 100,
+							"dag_ids": ["sample_select"],
+							"states": ["queued", "running"],
+							"execution_date_gte": "2021-05-20T02:00:00+00:00",
+							"execution_date_lte": "2021-05-25T02:00:00+00:00"
+						}`, string(body))
+						respBody = `{
+							"dag_runs": [
+								{"dag_run_id": "scheduled__2021-05-20T02:00:00+00:00", "state": "running"},
+								{"dag_run_id": "scheduled__2021-05-21T02:00:00+00:00", "state": "queued"}
+							],
+							"total_entries": 2
+						}`
+					} else {
+						assert.JSONEq(t, `{"state": "failed"}`, string(body))
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(respBody))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.CancelRuns(ctx, projSpec, "sample_select", startDateTime, endDateTime)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{
+				"POST " + host + "/api/v1/dags/~/dagRuns/list",
+				"PATCH " + host + "/api/v1/dags/sample_select/dagRuns/scheduled__2021-05-20T02:00:00+00:00",
+				"PATCH " + host + "/api/v1/dags/sample_select/dagRuns/scheduled__2021-05-21T02:00:00+00:00",
+			}, requests)
+		})
+		t.Run("should fail if host fails to update dag run", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					if req.Method == http.MethodPost {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: ioutil.NopCloser(bytes.NewReader([]byte(
+								`{"dag_runs": [{"dag_run_id": "manual__2021-05-20", "state": "running"}], "total_entries": 1}`))),
+						}, nil
+					}
+					return &http.Response{
+						StatusCode: http.StatusInternalServerError,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("INTERNAL ERROR"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.CancelRuns(ctx, projSpec, "sample_select", startDateTime, endDateTime)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "failed to cancel airflow dag run")
+		})
+	})
+	t.Run("Pause", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		namespaceSpec := models.NamespaceSpec{
+			Name: "test-ns",
+			ProjectSpec: models.ProjectSpec{
+				Name: "test-proj",
+				Config: map[string]string{
+					models.ProjectSchedulerHost: host,
+				},
+				Secret: []models.ProjectSecretItem{
+					{
+						Name:  models.ProjectSchedulerAuth,
+						Value: "admin:admin",
+					},
+				},
+			},
+		}
+
+		t.Run("should patch paused flag of dag", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPatch, req.Method)
+					assert.Equal(t, host+"/api/v1/dags/sample_select?update_mask=is_paused", req.URL.String())
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+					assert.JSONEq(t, `{"is_paused": true}`, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.Pause(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if host fails to return OK", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("DAG not found"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.Pause(ctx, namespaceSpec, "sample_select")
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("Resume", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		namespaceSpec := models.NamespaceSpec{
+			Name: "test-ns",
+			ProjectSpec: models.ProjectSpec{
+				Name: "test-proj",
+				Config: map[string]string{
+					models.ProjectSchedulerHost: host,
+				},
+				Secret: []models.ProjectSecretItem{
+					{
+						Name:  models.ProjectSchedulerAuth,
+						Value: "admin:admin",
+					},
+				},
+			},
+		}
+
+		t.Run("should patch paused flag of dag", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPatch, req.Method)
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+					assert.JSONEq(t, `{"is_paused": false}`, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.Resume(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("TriggerRun", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		namespaceSpec := models.NamespaceSpec{
+			Name: "test-ns",
+			ProjectSpec: models.ProjectSpec{
+				Name: "test-proj",
+				Config: map[string]string{
+					models.ProjectSchedulerHost: host,
+				},
+				Secret: []models.ProjectSecretItem{
+					{
+						Name:  models.ProjectSchedulerAuth,
+						Value: "admin:admin",
+					},
+				},
+			},
+		}
+		scheduledAt := time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC)
+
+		t.Run("should create dag run with logical date and conf", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPost, req.Method)
+					assert.Equal(t, host+"/api/v1/dags/sample_select/dagRuns", req.URL.String())
+					body, err := ioutil.ReadAll(req.Body)
+					assert.Nil(t, err)
+					assert.JSONEq(t, `{"execution_date": "2021-11-03T02:00:00+00:00", "conf": {"BACKFILL": "true"}}`, string(body))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.TriggerRun(ctx, namespaceSpec, "sample_select", scheduledAt, map[string]string{"BACKFILL": "true"})
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if run already exists for the logical date", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusConflict,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("DAGRun already exists"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			err := air.TriggerRun(ctx, namespaceSpec, "sample_select", scheduledAt, nil)
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("GetJobRunDetails", func(t *testing.T) {
+		host := "http://airflow.example.io"
+		projectSpec := models.ProjectSpec{
+			Name: "test-proj",
+			Config: map[string]string{
+				models.ProjectSchedulerHost: host,
+			},
+			Secret: []models.ProjectSecretItem{
+				{
+					Name:  models.ProjectSchedulerAuth,
+					Value: "admin:admin",
+				},
+			},
+		}
+		scheduledAt := time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC)
+
+		t.Run("should return details of all task instances of the dag run", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					if req.Method == http.MethodPost {
+						assert.Equal(t, host+"/api/v1/dags/~/dagRuns/list", req.URL.String())
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"dag_runs": [{
+								"dag_run_id": "scheduled__2021-11-03T02:00:00+00:00",
+								"execution_date": "2021-11-03T02:00:00+00:00",
+								"state": "running"
+							}], "total_entries": 1}`))),
+						}, nil
+					}
+					assert.Equal(t, "/api/v1/dags/sample_select/dagRuns/scheduled__2021-11-03T02:00:00+00:00/taskInstances", req.URL.Path)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"task_instances": [{
+							"task_id": "wait_upstream-table-bq",
+							"state": "success",
+							"try_number": 1,
+							"start_date": "2021-11-03T02:01:00.123456+00:00",
+							"end_date": "2021-11-03T02:11:00+00:00",
+							"duration": 600.5
+						}, {
+							"task_id": "bq2bq",
+							"state": "running",
+							"try_number": 2,
+							"start_date": "2021-11-03T02:12:00+00:00",
+							"end_date": null,
+							"duration": null
+						}, {
+							"task_id": "hook_predator",
+							"state": null,
+							"try_number": 0,
+							"start_date": null,
+							"end_date": null,
+							"duration": null
+						}], "total_entries": 3}`))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			runDetails, err := air.GetJobRunDetails(ctx, projectSpec, "sample_select", scheduledAt)
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateRunning, runDetails.State)
+			assert.Equal(t, []models.InstanceDetails{
+				{
+					Name:      "upstream-table-bq",
+					Type:      models.InstanceTypeSensor,
+					State:     models.RunStateSuccess,
+					TryNumber: 1,
+					StartedAt: time.Date(2021, 11, 3, 2, 1, 0, 123456000, time.UTC),
+					EndedAt:   time.Date(2021, 11, 3, 2, 11, 0, 0, time.UTC),
+					Duration:  time.Second*600 + time.Millisecond*500,
+				},
+				{
+					Name:      "bq2bq",
+					Type:      models.InstanceTypeTask,
+					State:     models.RunStateRunning,
+					TryNumber: 2,
+					StartedAt: time.Date(2021, 11, 3, 2, 12, 0, 0, time.UTC),
+				},
+				{
+					Name: "predator",
+					Type: models.InstanceTypeHook,
+				},
+			}, runDetails.Instances)
+		})
+		t.Run("should fail if no dag run exists for the scheduled time", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"dag_runs": [], "total_entries": 0}`))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client, nil)
+			_, err := air.GetJobRunDetails(ctx, projectSpec, "sample_select", scheduledAt)
+			assert.Equal(t, "no run of sample_select found scheduled at 2021-11-03T02:00:00+00:00", err.Error())
+		})
+	})
 	t.Run("GetJobRunStatus", func(t *testing.T) {
 		host := "http://airflow.example.io"
 		dagStatusBatchUrl := "api/v1/dags/~/dagRuns/list"
@@ -148,6 +148,20 @@ func TestCompilerIntegration(t *testing.T) {
 					},
 				},
 			},
+			Resources: models.JobSpecResources{
+				Request: models.JobSpecResourceConfig{
+					CPU:    "250m",
+					Memory: "128Mi",
+				},
+				Limit: models.JobSpecResourceConfig{
+					Memory: "1Gi",
+				},
+			},
+			Execution: models.JobSpecExecution{
+				Pool:    "bigquery",
+				Queue:   "heavy",
+				Timeout: time.Hour * 2,
+			},
 		},
 		Schedule: models.JobSpecSchedule{
 			StartDate: time.Date(2000, 11, 11, 0, 0, 0, 0, time.UTC),
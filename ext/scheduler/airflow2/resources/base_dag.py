@@ -79,6 +79,35 @@ transformation_{{$baseTaskSchema.Name | replace "-" "__dash__" | replace "." "__
     ],
 {{- if gt .SLAMissDurationInSec 0 }}
     sla=timedelta(seconds={{ .SLAMissDurationInSec }}),
+{{- end }}
+{{- if $.Job.Behavior.Execution.Pool }}
+    pool={{ $.Job.Behavior.Execution.Pool | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Execution.Queue }}
+    queue={{ $.Job.Behavior.Execution.Queue | quote }},
+{{- end }}
+{{- if gt $.Job.Behavior.Execution.Timeout.Nanoseconds 0 }}
+    execution_timeout=timedelta(seconds={{ $.Job.Behavior.Execution.Timeout.Seconds }}),
+{{- end }}
+{{- if not $.Job.Behavior.Resources.IsEmpty }}
+    resources=k8s.V1ResourceRequirements(
+        requests={
+{{- if $.Job.Behavior.Resources.Request.CPU }}
+            "cpu": {{ $.Job.Behavior.Resources.Request.CPU | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Resources.Request.Memory }}
+            "memory": {{ $.Job.Behavior.Resources.Request.Memory | quote }},
+{{- end }}
+        },
+        limits={
+{{- if $.Job.Behavior.Resources.Limit.CPU }}
+            "cpu": {{ $.Job.Behavior.Resources.Limit.CPU | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Resources.Limit.Memory }}
+            "memory": {{ $.Job.Behavior.Resources.Limit.Memory | quote }},
+{{- end }}
+        },
+    ),
 {{- end }}
     reattach_on_restart=True
 )
@@ -121,6 +150,35 @@ hook_{{$hookSchema.Name | replace "-" "__dash__"}} = SuperKubernetesPodOperator(
         k8s.V1EnvVar(name="SCHEDULED_AT",value='{{ "{{ next_execution_date }}" }}'),
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     ],
+{{- if $.Job.Behavior.Execution.Pool }}
+    pool={{ $.Job.Behavior.Execution.Pool | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Execution.Queue }}
+    queue={{ $.Job.Behavior.Execution.Queue | quote }},
+{{- end }}
+{{- if gt $.Job.Behavior.Execution.Timeout.Nanoseconds 0 }}
+    execution_timeout=timedelta(seconds={{ $.Job.Behavior.Execution.Timeout.Seconds }}),
+{{- end }}
+{{- if not $.Job.Behavior.Resources.IsEmpty }}
+    resources=k8s.V1ResourceRequirements(
+        requests={
+{{- if $.Job.Behavior.Resources.Request.CPU }}
+            "cpu": {{ $.Job.Behavior.Resources.Request.CPU | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Resources.Request.Memory }}
+            "memory": {{ $.Job.Behavior.Resources.Request.Memory | quote }},
+{{- end }}
+        },
+        limits={
+{{- if $.Job.Behavior.Resources.Limit.CPU }}
+            "cpu": {{ $.Job.Behavior.Resources.Limit.CPU | quote }},
+{{- end }}
+{{- if $.Job.Behavior.Resources.Limit.Memory }}
+            "memory": {{ $.Job.Behavior.Resources.Limit.Memory | quote }},
+{{- end }}
+        },
+    ),
+{{- end }}
     {{ if eq $hookSchema.HookType $.HookTypeFail -}}
         trigger_rule="one_failed",
     {{ end -}}
@@ -75,6 +75,18 @@ transformation_bq = SuperKubernetesPodOperator(
         k8s.V1EnvVar(name="SCHEDULED_AT",value='{{ next_execution_date }}'),
     ],
     sla=timedelta(seconds=7200),
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources=k8s.V1ResourceRequirements(
+        requests={
+            "cpu": "250m",
+            "memory": "128Mi",
+        },
+        limits={
+            "memory": "1Gi",
+        },
+    ),
     reattach_on_restart=True
 )
 
@@ -112,6 +124,18 @@ hook_transporter = SuperKubernetesPodOperator(
         k8s.V1EnvVar(name="SCHEDULED_AT",value='{{ next_execution_date }}'),
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     ],
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources=k8s.V1ResourceRequirements(
+        requests={
+            "cpu": "250m",
+            "memory": "128Mi",
+        },
+        limits={
+            "memory": "1Gi",
+        },
+    ),
     reattach_on_restart=True
 )
 
@@ -141,6 +165,18 @@ hook_predator = SuperKubernetesPodOperator(
         k8s.V1EnvVar(name="SCHEDULED_AT",value='{{ next_execution_date }}'),
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     ],
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources=k8s.V1ResourceRequirements(
+        requests={
+            "cpu": "250m",
+            "memory": "128Mi",
+        },
+        limits={
+            "memory": "1Gi",
+        },
+    ),
     reattach_on_restart=True
 )
 
@@ -170,6 +206,18 @@ hook_hook__dash__for__dash__fail = SuperKubernetesPodOperator(
         k8s.V1EnvVar(name="SCHEDULED_AT",value='{{ next_execution_date }}'),
         # rest of the env vars are pulled from the container by making a GRPC call to optimus
     ],
+    pool="bigquery",
+    queue="heavy",
+    execution_timeout=timedelta(seconds=7200),
+    resources=k8s.V1ResourceRequirements(
+        requests={
+            "cpu": "250m",
+            "memory": "128Mi",
+        },
+        limits={
+            "memory": "1Gi",
+        },
+    ),
     trigger_rule="one_failed",
     reattach_on_restart=True
 )
@@ -0,0 +1,164 @@
+package airflow2
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SyncConnectionsConfigKey is the project config holding comma separated
+	// names of secrets synced as airflow connections. Value of these secrets
+	// should be a json of connection fields e.g. conn_type, host, login
+	SyncConnectionsConfigKey = "SCHEDULER_SYNC_CONNECTIONS"
+
+	// SyncVariablesConfigKey is the project config holding comma separated
+	// names of secrets synced as airflow variables
+	SyncVariablesConfigKey = "SCHEDULER_SYNC_VARIABLES"
+
+	connectionsURL = "api/v1/connections"
+	variablesURL   = "api/v1/variables"
+)
+
+// SyncSecret upserts airflow connection or variable of the secret if the
+// project selected it for syncing
+func (s *scheduler) SyncSecret(ctx context.Context, proj models.ProjectSpec, secret models.ProjectSecretItem) error {
+	if isSyncedSecret(proj, SyncConnectionsConfigKey, secret.Name) {
+		if err := s.upsertConnection(ctx, proj, secret); err != nil {
+			return err
+		}
+	}
+	if isSyncedSecret(proj, SyncVariablesConfigKey, secret.Name) {
+		if err := s.upsertVariable(ctx, proj, secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncSecrets pushes every secret selected by the project to airflow
+func (s *scheduler) syncSecrets(ctx context.Context, proj models.ProjectSpec) error {
+	var err error
+	for _, configKey := range []string{SyncConnectionsConfigKey, SyncVariablesConfigKey} {
+		for _, name := range syncedSecretNames(proj, configKey) {
+			value, ok := proj.Secret.GetByName(name)
+			if !ok {
+				err = multierror.Append(err, errors.Errorf("secret %s selected for syncing is not registered in project %s", name, proj.Name))
+				continue
+			}
+			secret := models.ProjectSecretItem{Name: name, Value: value}
+			if configKey == SyncConnectionsConfigKey {
+				if syncErr := s.upsertConnection(ctx, proj, secret); syncErr != nil {
+					err = multierror.Append(err, syncErr)
+				}
+				continue
+			}
+			if syncErr := s.upsertVariable(ctx, proj, secret); syncErr != nil {
+				err = multierror.Append(err, syncErr)
+			}
+		}
+	}
+	return err
+}
+
+func (s *scheduler) upsertConnection(ctx context.Context, proj models.ProjectSpec, secret models.ProjectSecretItem) error {
+	// secret value is never added to errors
+	var connection map[string]interface{}
+	if err := json.Unmarshal([]byte(secret.Value), &connection); err != nil {
+		return errors.Errorf("secret %s synced as airflow connection should be a json of connection fields", secret.Name)
+	}
+	if connType, ok := connection["conn_type"].(string); !ok || connType == "" {
+		return errors.Errorf("secret %s synced as airflow connection should have conn_type", secret.Name)
+	}
+	connection["connection_id"] = secret.Name
+
+	payload, err := json.Marshal(connection)
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, proj, connectionsURL, secret.Name, payload)
+}
+
+func (s *scheduler) upsertVariable(ctx context.Context, proj models.ProjectSpec, secret models.ProjectSecretItem) error {
+	payload, err := json.Marshal(map[string]string{
+		"key":   secret.Name,
+		"value": secret.Value,
+	})
+	if err != nil {
+		return err
+	}
+	return s.upsert(ctx, proj, variablesURL, secret.Name, payload)
+}
+
+// upsert updates the airflow resource identified by id, it is created
+// instead if airflow doesn't have it yet
+func (s *scheduler) upsert(ctx context.Context, proj models.ProjectSpec, resourceURL, id string, payload []byte) error {
+	schdHost, authToken, err := s.getHostAuth(proj)
+	if err != nil {
+		return err
+	}
+	schdHost = strings.Trim(schdHost, "/")
+
+	patchURL := fmt.Sprintf("%s/%s/%s", schdHost, resourceURL, url.PathEscape(id))
+	statusCode, err := s.sendJSON(ctx, http.MethodPatch, patchURL, authToken, payload)
+	if err != nil {
+		return err
+	}
+	if statusCode == http.StatusNotFound {
+		postURL := fmt.Sprintf("%s/%s", schdHost, resourceURL)
+		if statusCode, err = s.sendJSON(ctx, http.MethodPost, postURL, authToken, payload); err != nil {
+			return err
+		}
+	}
+	if statusCode != http.StatusOK {
+		return errors.Errorf("failed to sync %s with airflow at %s: %d", id, schdHost, statusCode)
+	}
+	return nil
+}
+
+func (s *scheduler) sendJSON(ctx context.Context, method, reqURL, authToken string, payload []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewBuffer(payload))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build http request for %s", reqURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(authToken))))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to send request to %s", reqURL)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+func syncedSecretNames(proj models.ProjectSpec, configKey string) []string {
+	var names []string
+	for _, name := range strings.Split(proj.Config[configKey], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func isSyncedSecret(proj models.ProjectSpec, configKey, secretName string) bool {
+	for _, name := range syncedSecretNames(proj, configKey) {
+		if name == secretName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,513 @@
+package argo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/ext/scheduler/airflow2"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+
+	_ "embed"
+)
+
+//go:embed resources/cron_workflow.yaml
+var resCronWorkflow []byte
+
+var (
+	ErrEmptyJobName = errors.New("job name cannot be an empty string")
+)
+
+const (
+	// ProjectWorkflowNamespaceKey is the project config for kubernetes
+	// namespace where workflows of the project are deployed
+	ProjectWorkflowNamespaceKey = "SCHEDULER_NAMESPACE"
+	defaultWorkflowNamespace    = "default"
+
+	workflowListURL        = "api/v1/workflows/%s"
+	workflowResubmitURL    = "api/v1/workflows/%s/%s/resubmit"
+	workflowSubmitURL      = "api/v1/workflows/%s/submit"
+	cronWorkflowSuspendURL = "api/v1/cron-workflows/%s/%s/suspend"
+	cronWorkflowResumeURL  = "api/v1/cron-workflows/%s/%s/resume"
+	jobLabel               = "optimus.odpf.io/job"
+	scheduledTimeKey       = "workflows.argoproj.io/scheduled-time"
+	workflowNameMaxLength  = 52
+
+	JobsDir       = "workflows"
+	JobsExtension = ".yaml"
+
+	ConcurrentTicketPerSec = 40
+	ConcurrentLimit        = 600
+)
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// scheduler deploys jobs as argo CronWorkflow manifests to the project
+// storage, these are expected to be synced to the cluster by the platform.
+// Runs of the deployed workflows are managed through argo server api
+type scheduler struct {
+	bucketFac  airflow2.BucketFactory
+	httpClient HTTPClient
+	compiler   models.JobCompiler
+}
+
+func (s *scheduler) GetName() string {
+	return "argo"
+}
+
+func (s *scheduler) GetTemplate() []byte {
+	return resCronWorkflow
+}
+
+// Bootstrap needs no action as workflows don't share any library
+func (s *scheduler) Bootstrap(ctx context.Context, proj models.ProjectSpec) error {
+	return nil
+}
+
+func (s *scheduler) CompileJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) (models.Job, error) {
+	return s.compiler.Compile(s.GetTemplate(), namespace, job)
+}
+
+func (s *scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) error {
+	_, err := s.compiler.Compile(s.GetTemplate(), namespace, job)
+	return err
+}
+
+func (s *scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSpec, jobs []models.JobSpec,
+	progressObserver progress.Observer) error {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
+	for _, j := range jobs {
+		runner.Add(func(currentJobSpec models.JobSpec) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				compiledJob, err := s.compiler.Compile(s.GetTemplate(), namespace, currentJobSpec)
+				if err != nil {
+					return nil, err
+				}
+				s.notifyProgress(progressObserver, &models.EventJobSpecCompiled{
+					Name: compiledJob.Name,
+				})
+
+				blobKey := airflow2.PathFromJobName(JobsDir, namespace.ID.String(), compiledJob.Name, JobsExtension)
+				if err := bucket.WriteAll(ctx, blobKey, compiledJob.Contents, nil); err != nil {
+					s.notifyProgress(progressObserver, &models.EventJobUpload{
+						Name: compiledJob.Name,
+						Err:  err,
+					})
+					return nil, err
+				}
+				s.notifyProgress(progressObserver, &models.EventJobUpload{
+					Name: compiledJob.Name,
+					Err:  nil,
+				})
+				return nil, nil
+			}
+		}(j))
+	}
+	for _, result := range runner.Run() {
+		if result.Err != nil {
+			err = multierror.Append(err, result.Err)
+		}
+	}
+	return err
+}
+
+func (s *scheduler) DeleteJobs(ctx context.Context, namespace models.NamespaceSpec, jobNames []string,
+	progressObserver progress.Observer) error {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	for _, jobName := range jobNames {
+		if strings.TrimSpace(jobName) == "" {
+			return ErrEmptyJobName
+		}
+		blobKey := airflow2.PathFromJobName(JobsDir, namespace.ID.String(), jobName, JobsExtension)
+		if err := bucket.Delete(ctx, blobKey); err != nil {
+			// ignore missing files
+			if gcerrors.Code(err) != gcerrors.NotFound {
+				return err
+			}
+		}
+		s.notifyProgress(progressObserver, &models.EventJobRemoteDelete{
+			Name: jobName,
+		})
+	}
+	return nil
+}
+
+func (s *scheduler) ListJobs(ctx context.Context, namespace models.NamespaceSpec, opts models.SchedulerListOptions) ([]models.Job, error) {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	namespaceID := namespace.ID.String()
+	var jobs []models.Job
+	// get all items under namespace directory
+	it := bucket.List(&blob.ListOptions{
+		Prefix: airflow2.PathForJobDirectory(JobsDir, namespaceID),
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if strings.HasSuffix(obj.Key, JobsExtension) {
+			jobs = append(jobs, models.Job{
+				Name: airflow2.JobNameFromPath(obj.Key, JobsExtension),
+			})
+		}
+	}
+
+	if opts.OnlyName {
+		return jobs, nil
+	}
+	for idx, job := range jobs {
+		jobs[idx].Contents, err = bucket.ReadAll(ctx, airflow2.PathFromJobName(JobsDir, namespaceID, job.Name, JobsExtension))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+func (s *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus, error) {
+	workflows, err := s.listWorkflows(ctx, projSpec, jobName, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledWorkflows, err := latestScheduledWorkflows(workflows)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobStatus []models.JobStatus
+	for _, wf := range scheduledWorkflows {
+		jobStatus = append(jobStatus, models.JobStatus{
+			ScheduledAt: wf.scheduledTime,
+			State:       wf.state(),
+		})
+	}
+	return jobStatus, nil
+}
+
+// GetJobRunStatus fetches workflows of the job in batches and filters the
+// ones scheduled between start and end dates
+func (s *scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time,
+	endDate time.Time, batchSize int) ([]models.JobStatus, error) {
+	workflows, err := s.listWorkflows(ctx, projectSpec, jobName, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledWorkflows, err := latestScheduledWorkflows(workflows)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobStatus []models.JobStatus
+	for _, wf := range scheduledWorkflows {
+		if wf.scheduledTime.Before(startDate) || wf.scheduledTime.After(endDate) {
+			continue
+		}
+		jobStatus = append(jobStatus, models.JobStatus{
+			ScheduledAt: wf.scheduledTime,
+			State:       wf.state(),
+		})
+	}
+	return jobStatus, nil
+}
+
+// Clear resubmits workflows scheduled between start and end dates, argo
+// keeps scheduled time of the original workflow in the resubmitted one so
+// only the latest workflow of each scheduled time is resubmitted
+func (s *scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
+	workflows, err := s.listWorkflows(ctx, projSpec, jobName, 0)
+	if err != nil {
+		return err
+	}
+	scheduledWorkflows, err := latestScheduledWorkflows(workflows)
+	if err != nil {
+		return err
+	}
+
+	wfNamespace := workflowNamespace(projSpec)
+	var clearErrors error
+	for _, wf := range scheduledWorkflows {
+		if wf.scheduledTime.Before(startDate) || wf.scheduledTime.After(endDate) {
+			continue
+		}
+		resubmitURL := fmt.Sprintf(workflowResubmitURL, wfNamespace, wf.Metadata.Name)
+		if err := s.callAPI(ctx, projSpec, http.MethodPut, resubmitURL, map[string]interface{}{
+			"namespace": wfNamespace,
+			"name":      wf.Metadata.Name,
+			"memoized":  false,
+		}, nil); err != nil {
+			clearErrors = multierror.Append(clearErrors, err)
+		}
+	}
+	return clearErrors
+}
+
+func (s *scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	wfNamespace := workflowNamespace(namespace.ProjectSpec)
+	name := workflowName(jobName)
+	return s.callAPI(ctx, namespace.ProjectSpec, http.MethodPut, fmt.Sprintf(cronWorkflowSuspendURL, wfNamespace, name),
+		map[string]string{
+			"namespace": wfNamespace,
+			"name":      name,
+		}, nil)
+}
+
+func (s *scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	wfNamespace := workflowNamespace(namespace.ProjectSpec)
+	name := workflowName(jobName)
+	return s.callAPI(ctx, namespace.ProjectSpec, http.MethodPut, fmt.Sprintf(cronWorkflowResumeURL, wfNamespace, name),
+		map[string]string{
+			"namespace": wfNamespace,
+			"name":      name,
+		}, nil)
+}
+
+// TriggerRun submits a workflow from the cron workflow of the job, scheduled
+// time is annotated the same way as cron triggered workflows and config is
+// passed as workflow parameters
+func (s *scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	wfNamespace := workflowNamespace(namespace.ProjectSpec)
+	var parameters []string
+	for key, value := range config {
+		parameters = append(parameters, fmt.Sprintf("%s=%s", key, value))
+	}
+	return s.callAPI(ctx, namespace.ProjectSpec, http.MethodPost, fmt.Sprintf(workflowSubmitURL, wfNamespace),
+		map[string]interface{}{
+			"namespace":    wfNamespace,
+			"resourceKind": "cronwf",
+			"resourceName": workflowName(jobName),
+			"submitOptions": map[string]interface{}{
+				"annotations": fmt.Sprintf("%s=%s", scheduledTimeKey, scheduledAt.UTC().Format(time.RFC3339)),
+				"parameters":  parameters,
+			},
+		}, nil)
+}
+
+// listWorkflows returns all the workflows created for the job, these are
+// fetched in pages of provided size if it is positive
+func (s *scheduler) listWorkflows(ctx context.Context, projSpec models.ProjectSpec, jobName string, pageSize int) ([]workflow, error) {
+	var (
+		workflows     []workflow
+		continueToken string
+	)
+	for {
+		query := url.Values{}
+		query.Set("listOptions.labelSelector", fmt.Sprintf("%s=%s", jobLabel, jobName))
+		if pageSize > 0 {
+			query.Set("listOptions.limit", fmt.Sprintf("%d", pageSize))
+		}
+		if continueToken != "" {
+			query.Set("listOptions.continue", continueToken)
+		}
+		listURL := fmt.Sprintf(workflowListURL, workflowNamespace(projSpec)) + "?" + query.Encode()
+
+		var response struct {
+			Metadata struct {
+				Continue string `json:"continue"`
+			} `json:"metadata"`
+			Items []workflow `json:"items"`
+		}
+		if err := s.callAPI(ctx, projSpec, http.MethodGet, listURL, nil, &response); err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, response.Items...)
+
+		continueToken = response.Metadata.Continue
+		if continueToken == "" {
+			break
+		}
+	}
+	return workflows, nil
+}
+
+// callAPI makes a request to argo server with the body encoded as json,
+// response is decoded in provided value if it is not nil
+func (s *scheduler) callAPI(ctx context.Context, projSpec models.ProjectSpec, method, path string,
+	body interface{}, response interface{}) error {
+	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
+	if !ok {
+		return errors.Errorf("scheduler host not set for %s", projSpec.Name)
+	}
+	schdHost = strings.Trim(schdHost, "/")
+	requestURL := fmt.Sprintf("%s/%s", schdHost, path)
+
+	var reqBody io.Reader
+	if body != nil {
+		jsonStr, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrapf(err, "failed to build request body for %s", requestURL)
+		}
+		reqBody = bytes.NewBuffer(jsonStr)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, reqBody)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", requestURL)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	// argo server accepts kubernetes service account tokens
+	if authToken, ok := projSpec.Secret.GetByName(models.ProjectSchedulerAuth); ok {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+	}
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call argo server at %s", requestURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call argo server at %s: %d", requestURL, resp.StatusCode)
+	}
+	if response == nil {
+		return nil
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read argo response")
+	}
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return errors.Wrapf(err, "json error: %s", string(respBody))
+	}
+	return nil
+}
+
+func (s *scheduler) notifyProgress(po progress.Observer, event progress.Event) {
+	if po == nil {
+		return
+	}
+	po.Notify(event)
+}
+
+// workflow is an argo workflow as returned by argo server api
+type workflow struct {
+	Metadata struct {
+		Name              string            `json:"name"`
+		Annotations       map[string]string `json:"annotations"`
+		CreationTimestamp time.Time         `json:"creationTimestamp"`
+	} `json:"metadata"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// scheduledWorkflow is a workflow created by a schedule along with its
+// scheduled time
+type scheduledWorkflow struct {
+	workflow
+	scheduledTime time.Time
+}
+
+// latestScheduledWorkflows returns the newest workflow for each scheduled
+// time in the order they were listed, workflows resubmitted on clear carry
+// the scheduled time of the original one. Workflows not created by a
+// schedule are skipped
+func latestScheduledWorkflows(workflows []workflow) ([]scheduledWorkflow, error) {
+	var scheduledWorkflows []scheduledWorkflow
+	indexByTime := map[time.Time]int{}
+	for _, wf := range workflows {
+		scheduledAt, ok, err := wf.scheduledAt()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read scheduled time of workflow %s", wf.Metadata.Name)
+		}
+		if !ok {
+			continue
+		}
+		idx, seen := indexByTime[scheduledAt]
+		if !seen {
+			indexByTime[scheduledAt] = len(scheduledWorkflows)
+			scheduledWorkflows = append(scheduledWorkflows, scheduledWorkflow{workflow: wf, scheduledTime: scheduledAt})
+			continue
+		}
+		if wf.Metadata.CreationTimestamp.After(scheduledWorkflows[idx].Metadata.CreationTimestamp) {
+			scheduledWorkflows[idx].workflow = wf
+		}
+	}
+	return scheduledWorkflows, nil
+}
+
+// scheduledAt returns false if workflow was not created by a schedule
+func (wf workflow) scheduledAt() (time.Time, bool, error) {
+	scheduledTime, ok := wf.Metadata.Annotations[scheduledTimeKey]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	scheduledAt, err := time.Parse(time.RFC3339, scheduledTime)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return scheduledAt.UTC(), true, nil
+}
+
+func (wf workflow) state() models.JobRunState {
+	switch wf.Status.Phase {
+	case "Succeeded":
+		return models.RunStateSuccess
+	case "Failed", "Error":
+		return models.RunStateFailed
+	case "Running":
+		return models.RunStateRunning
+	}
+	return models.RunStatePending
+}
+
+// workflowName converts job name to the name of its cron workflow same as
+// the template, kubernetes only allows lower case alphanumerics and dashes
+func workflowName(jobName string) string {
+	name := strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(jobName))
+	if len(name) > workflowNameMaxLength {
+		name = name[:workflowNameMaxLength]
+	}
+	return name
+}
+
+func workflowNamespace(projSpec models.ProjectSpec) string {
+	if wfNamespace, ok := projSpec.Config[ProjectWorkflowNamespaceKey]; ok && wfNamespace != "" {
+		return wfNamespace
+	}
+	return defaultWorkflowNamespace
+}
+
+func NewScheduler(bf airflow2.BucketFactory, httpClient HTTPClient, compiler models.JobCompiler) *scheduler {
+	return &scheduler{
+		bucketFac:  bf,
+		httpClient: httpClient,
+		compiler:   compiler,
+	}
+}
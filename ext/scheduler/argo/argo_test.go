@@ -0,0 +1,361 @@
+package argo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/ext/scheduler/airflow2"
+	"github.com/odpf/optimus/ext/scheduler/argo"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+type MockHTTPClient struct {
+	DoFunc func(req *http.Request) (*http.Response, error)
+}
+
+func (m *MockHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if m.DoFunc != nil {
+		return m.DoFunc(req)
+	}
+	// default if none provided
+	return &http.Response{}, nil
+}
+
+type MockedBucketFactory struct {
+	mock.Mock
+}
+
+func (m *MockedBucketFactory) New(ctx context.Context, proj models.ProjectSpec) (airflow2.Bucket, error) {
+	args := m.Called(ctx, proj)
+	return args.Get(0).(airflow2.Bucket), args.Error(1)
+}
+
+// MockedBucket keeps the in memory bucket readable after scheduler closes it
+type MockedBucket struct {
+	*blob.Bucket
+}
+
+func (m *MockedBucket) Close() error {
+	return nil
+}
+
+type MockedCompiler struct {
+	mock.Mock
+}
+
+func (srv *MockedCompiler) Compile(template []byte, namespace models.NamespaceSpec, jobSpec models.JobSpec) (models.Job, error) {
+	args := srv.Called(template, namespace, jobSpec)
+	return args.Get(0).(models.Job), args.Error(1)
+}
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+func TestArgo(t *testing.T) {
+	ctx := context.Background()
+	host := "http://argo.example.io"
+	projectSpec := models.ProjectSpec{
+		Name: "proj-name",
+		Config: map[string]string{
+			models.ProjectStoragePathKey:     "gs://mybucket/hello",
+			models.ProjectSchedulerHost:      host,
+			argo.ProjectWorkflowNamespaceKey: "optimus",
+		},
+		Secret: []models.ProjectSecretItem{
+			{
+				Name:  models.ProjectSchedulerAuth,
+				Value: "service-account-token",
+			},
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "local-namespace",
+		ProjectSpec: projectSpec,
+	}
+	workflowList := `{"metadata": {}, "items": [
+		{"metadata": {"name": "sample-select-1635904800", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-03T02:00:00Z"}}, "status": {"phase": "Succeeded"}},
+		{"metadata": {"name": "sample-select-1635991200", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-04T02:00:00Z"}}, "status": {"phase": "Failed"}},
+		{"metadata": {"name": "sample-select-1636077600", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-05T02:00:00Z"}}, "status": {"phase": "Running"}},
+		{"metadata": {"name": "sample-select-manual"}, "status": {"phase": "Succeeded"}}
+	]}`
+	resubmittedWorkflowList := `{"metadata": {}, "items": [
+		{"metadata": {"name": "sample-select-1635904800-resubmit", "creationTimestamp": "2021-11-10T10:00:00Z", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-03T02:00:00Z"}}, "status": {"phase": "Running"}},
+		{"metadata": {"name": "sample-select-1635904800", "creationTimestamp": "2021-11-03T02:00:00Z", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-03T02:00:00Z"}}, "status": {"phase": "Failed"}},
+		{"metadata": {"name": "sample-select-1635991200", "creationTimestamp": "2021-11-04T02:00:00Z", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-04T02:00:00Z"}}, "status": {"phase": "Succeeded"}}
+	]}`
+
+	t.Run("DeployJobs", func(t *testing.T) {
+		t.Run("should upload compiled cron workflows to the bucket", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{inMemBlob}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			jobSpec := models.JobSpec{
+				Name: "sample_select",
+			}
+			compiledJob := models.Job{
+				Name:     jobSpec.Name,
+				Contents: []byte("kind: CronWorkflow"),
+			}
+			compiler := new(MockedCompiler)
+			defer compiler.AssertExpectations(t)
+
+			air := argo.NewScheduler(mockBucketFac, nil, compiler)
+			compiler.On("Compile", air.GetTemplate(), namespaceSpec, jobSpec).Return(compiledJob, nil)
+			err := air.DeployJobs(ctx, namespaceSpec, []models.JobSpec{jobSpec}, nil)
+			assert.Nil(t, err)
+
+			storedBytes, err := inMemBlob.ReadAll(ctx, airflow2.PathFromJobName(argo.JobsDir, namespaceSpec.ID.String(),
+				jobSpec.Name, argo.JobsExtension))
+			assert.Nil(t, err)
+			assert.Equal(t, compiledJob.Contents, storedBytes)
+		})
+	})
+	t.Run("ListJobs", func(t *testing.T) {
+		t.Run("should list uploaded cron workflows of the namespace", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+			_ = inMemBlob.WriteAll(ctx, airflow2.PathFromJobName(argo.JobsDir, namespaceSpec.ID.String(),
+				"sample_select", argo.JobsExtension), []byte("kind: CronWorkflow"), (*blob.WriterOptions)(nil))
+			_ = inMemBlob.WriteAll(ctx, airflow2.PathFromJobName(argo.JobsDir, "other-namespace",
+				"other_select", argo.JobsExtension), []byte("kind: CronWorkflow"), (*blob.WriterOptions)(nil))
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{inMemBlob}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			air := argo.NewScheduler(mockBucketFac, nil, nil)
+			jobs, err := air.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.Job{
+				{
+					Name:     "sample_select",
+					Contents: []byte("kind: CronWorkflow"),
+				},
+			}, jobs)
+		})
+	})
+	t.Run("GetJobStatus", func(t *testing.T) {
+		t.Run("should return status of scheduled workflows of the job", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "/api/v1/workflows/optimus", req.URL.Path)
+					assert.Equal(t, "optimus.odpf.io/job=sample_select", req.URL.Query().Get("listOptions.labelSelector"))
+					assert.Equal(t, "Bearer service-account-token", req.Header.Get("Authorization"))
+					return jsonResponse(workflowList), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			status, err := air.GetJobStatus(ctx, projectSpec, "sample_select")
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{
+					ScheduledAt: time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateSuccess,
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 4, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateFailed,
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 5, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateRunning,
+				},
+			}, status)
+		})
+		t.Run("should return status of the latest workflow of each scheduled time", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return jsonResponse(resubmittedWorkflowList), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			status, err := air.GetJobStatus(ctx, projectSpec, "sample_select")
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{
+					ScheduledAt: time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateRunning,
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 4, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateSuccess,
+				},
+			}, status)
+		})
+		t.Run("should fail if argo server fails to respond OK", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusForbidden,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+					}, nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			_, err := air.GetJobStatus(ctx, projectSpec, "sample_select")
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("GetJobRunStatus", func(t *testing.T) {
+		t.Run("should fetch workflows in batches and filter the ones in range", func(t *testing.T) {
+			var continueTokens []string
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, "2", req.URL.Query().Get("listOptions.limit"))
+					continueToken := req.URL.Query().Get("listOptions.continue")
+					continueTokens = append(continueTokens, continueToken)
+					if continueToken == "" {
+						return jsonResponse(`{"metadata": {"continue": "page-2"}, "items": [
+							{"metadata": {"name": "sample-select-1635904800", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-03T02:00:00Z"}}, "status": {"phase": "Succeeded"}},
+							{"metadata": {"name": "sample-select-1635991200", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-04T02:00:00Z"}}, "status": {"phase": "Error"}}
+						]}`), nil
+					}
+					return jsonResponse(`{"metadata": {}, "items": [
+						{"metadata": {"name": "sample-select-1636077600", "annotations": {"workflows.argoproj.io/scheduled-time": "2021-11-05T02:00:00Z"}}, "status": {"phase": "Pending"}}
+					]}`), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			status, err := air.GetJobRunStatus(ctx, projectSpec, "sample_select",
+				time.Date(2021, 11, 4, 0, 0, 0, 0, time.UTC), time.Date(2021, 11, 6, 0, 0, 0, 0, time.UTC), 2)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"", "page-2"}, continueTokens)
+			assert.Equal(t, []models.JobStatus{
+				{
+					ScheduledAt: time.Date(2021, 11, 4, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateFailed,
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 5, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStatePending,
+				},
+			}, status)
+		})
+	})
+	t.Run("Clear", func(t *testing.T) {
+		t.Run("should resubmit workflows scheduled in the range", func(t *testing.T) {
+			var resubmitted []string
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					if req.Method == http.MethodGet {
+						return jsonResponse(workflowList), nil
+					}
+					assert.Equal(t, http.MethodPut, req.Method)
+					resubmitted = append(resubmitted, req.URL.Path)
+					return jsonResponse("{}"), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			err := air.Clear(ctx, projectSpec, "sample_select",
+				time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC), time.Date(2021, 11, 4, 2, 0, 0, 0, time.UTC))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{
+				"/api/v1/workflows/optimus/sample-select-1635904800/resubmit",
+				"/api/v1/workflows/optimus/sample-select-1635991200/resubmit",
+			}, resubmitted)
+		})
+		t.Run("should resubmit only the latest workflow of each scheduled time", func(t *testing.T) {
+			var resubmitted []string
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					if req.Method == http.MethodGet {
+						return jsonResponse(resubmittedWorkflowList), nil
+					}
+					resubmitted = append(resubmitted, req.URL.Path)
+					return jsonResponse("{}"), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			err := air.Clear(ctx, projectSpec, "sample_select",
+				time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC), time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{
+				"/api/v1/workflows/optimus/sample-select-1635904800-resubmit/resubmit",
+			}, resubmitted)
+		})
+	})
+	t.Run("Pause", func(t *testing.T) {
+		t.Run("should suspend cron workflow of the job", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPut, req.Method)
+					assert.Equal(t, host+"/api/v1/cron-workflows/optimus/sample-select/suspend", req.URL.String())
+					return jsonResponse("{}"), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			err := air.Pause(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("Resume", func(t *testing.T) {
+		t.Run("should resume cron workflow of the job", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPut, req.Method)
+					assert.Equal(t, host+"/api/v1/cron-workflows/optimus/sample-select/resume", req.URL.String())
+					return jsonResponse("{}"), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			err := air.Resume(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("TriggerRun", func(t *testing.T) {
+		t.Run("should submit workflow from cron workflow with scheduled time", func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPost, req.Method)
+					assert.Equal(t, host+"/api/v1/workflows/optimus/submit", req.URL.String())
+
+					var submitRequest struct {
+						ResourceKind  string `json:"resourceKind"`
+						ResourceName  string `json:"resourceName"`
+						SubmitOptions struct {
+							Annotations string   `json:"annotations"`
+							Parameters  []string `json:"parameters"`
+						} `json:"submitOptions"`
+					}
+					assert.Nil(t, json.NewDecoder(req.Body).Decode(&submitRequest))
+					assert.Equal(t, "cronwf", submitRequest.ResourceKind)
+					assert.Equal(t, "sample-select", submitRequest.ResourceName)
+					assert.Equal(t, "workflows.argoproj.io/scheduled-time=2021-11-03T02:00:00Z", submitRequest.SubmitOptions.Annotations)
+					assert.Equal(t, []string{"BACKFILL=true"}, submitRequest.SubmitOptions.Parameters)
+					return jsonResponse("{}"), nil
+				},
+			}
+
+			air := argo.NewScheduler(nil, client, nil)
+			err := air.TriggerRun(ctx, namespaceSpec, "sample_select", time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC),
+				map[string]string{"BACKFILL": "true"})
+			assert.Nil(t, err)
+		})
+	})
+}
@@ -0,0 +1,356 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/ext/scheduler/airflow2"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+	"gopkg.in/yaml.v3"
+
+	_ "embed"
+)
+
+//go:embed resources/cron_job.yaml
+var resCronJob []byte
+
+var (
+	ErrEmptyJobName = errors.New("job name cannot be an empty string")
+)
+
+const (
+	JobsDir       = "cronjobs"
+	JobsExtension = ".yaml"
+
+	ConcurrentTicketPerSec = 40
+	ConcurrentLimit        = 600
+)
+
+// RunRepoFactory manages execution instances of a job runs
+type RunRepoFactory interface {
+	New() store.JobRunRepository
+}
+
+// ProjectJobSpecRepoFactory is used to find job specs at project level
+type ProjectJobSpecRepoFactory interface {
+	New(proj models.ProjectSpec) store.ProjectJobSpecRepository
+}
+
+// scheduler deploys jobs as kubernetes CronJob manifests to the project
+// storage, these are expected to be synced to the cluster by the platform.
+// There is no scheduler api to talk to, runs are known to optimus only
+// through instances registered and reported by the pods while executing
+type scheduler struct {
+	bucketFac             airflow2.BucketFactory
+	compiler              models.JobCompiler
+	jobRunRepoFac         RunRepoFactory
+	projectJobSpecRepoFac ProjectJobSpecRepoFactory
+}
+
+func (s *scheduler) GetName() string {
+	return "kubernetes"
+}
+
+func (s *scheduler) GetTemplate() []byte {
+	return resCronJob
+}
+
+// Bootstrap needs no action as cron jobs don't share any library
+func (s *scheduler) Bootstrap(ctx context.Context, proj models.ProjectSpec) error {
+	return nil
+}
+
+func (s *scheduler) CompileJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) (models.Job, error) {
+	return s.compiler.Compile(s.GetTemplate(), namespace, job)
+}
+
+func (s *scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) error {
+	_, err := s.compiler.Compile(s.GetTemplate(), namespace, job)
+	return err
+}
+
+func (s *scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSpec, jobs []models.JobSpec,
+	progressObserver progress.Observer) error {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
+	for _, j := range jobs {
+		runner.Add(func(currentJobSpec models.JobSpec) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				compiledJob, err := s.compiler.Compile(s.GetTemplate(), namespace, currentJobSpec)
+				if err != nil {
+					return nil, err
+				}
+				s.notifyProgress(progressObserver, &models.EventJobSpecCompiled{
+					Name: compiledJob.Name,
+				})
+
+				blobKey := airflow2.PathFromJobName(JobsDir, namespace.ID.String(), compiledJob.Name, JobsExtension)
+				if err := bucket.WriteAll(ctx, blobKey, compiledJob.Contents, nil); err != nil {
+					s.notifyProgress(progressObserver, &models.EventJobUpload{
+						Name: compiledJob.Name,
+						Err:  err,
+					})
+					return nil, err
+				}
+				s.notifyProgress(progressObserver, &models.EventJobUpload{
+					Name: compiledJob.Name,
+					Err:  nil,
+				})
+				return nil, nil
+			}
+		}(j))
+	}
+	for _, result := range runner.Run() {
+		if result.Err != nil {
+			err = multierror.Append(err, result.Err)
+		}
+	}
+	return err
+}
+
+func (s *scheduler) DeleteJobs(ctx context.Context, namespace models.NamespaceSpec, jobNames []string,
+	progressObserver progress.Observer) error {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	for _, jobName := range jobNames {
+		if strings.TrimSpace(jobName) == "" {
+			return ErrEmptyJobName
+		}
+		blobKey := airflow2.PathFromJobName(JobsDir, namespace.ID.String(), jobName, JobsExtension)
+		if err := bucket.Delete(ctx, blobKey); err != nil {
+			// ignore missing files
+			if gcerrors.Code(err) != gcerrors.NotFound {
+				return err
+			}
+		}
+		s.notifyProgress(progressObserver, &models.EventJobRemoteDelete{
+			Name: jobName,
+		})
+	}
+	return nil
+}
+
+func (s *scheduler) ListJobs(ctx context.Context, namespace models.NamespaceSpec, opts models.SchedulerListOptions) ([]models.Job, error) {
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	namespaceID := namespace.ID.String()
+	var jobs []models.Job
+	// get all items under namespace directory
+	it := bucket.List(&blob.ListOptions{
+		Prefix: airflow2.PathForJobDirectory(JobsDir, namespaceID),
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if strings.HasSuffix(obj.Key, JobsExtension) {
+			jobs = append(jobs, models.Job{
+				Name: airflow2.JobNameFromPath(obj.Key, JobsExtension),
+			})
+		}
+	}
+
+	if opts.OnlyName {
+		return jobs, nil
+	}
+	for idx, job := range jobs {
+		jobs[idx].Contents, err = bucket.ReadAll(ctx, airflow2.PathFromJobName(JobsDir, namespaceID, job.Name, JobsExtension))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+func (s *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus, error) {
+	return s.getJobRunStatus(ctx, projSpec, jobName, time.Time{}, time.Now().UTC())
+}
+
+// GetJobRunStatus reads runs directly from the store so batchSize is not
+// needed for pagination
+func (s *scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time,
+	endDate time.Time, batchSize int) ([]models.JobStatus, error) {
+	return s.getJobRunStatus(ctx, projectSpec, jobName, startDate, endDate)
+}
+
+// Clear is not supported, kubernetes doesn't rerun jobs created by a cron job
+func (s *scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
+	return errors.Errorf("clearing runs is not supported by %s scheduler", s.GetName())
+}
+
+// Pause suspends the cron job in its stored manifest, it takes effect once
+// the manifest is synced to the cluster
+func (s *scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return s.setSuspend(ctx, namespace, jobName, true)
+}
+
+// Resume unsuspends the cron job in its stored manifest
+func (s *scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return s.setSuspend(ctx, namespace, jobName, false)
+}
+
+// TriggerRun is not supported, pods of a manually created job can't know the
+// time they are scheduled for
+func (s *scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	return errors.Errorf("triggering runs is not supported by %s scheduler", s.GetName())
+}
+
+func (s *scheduler) setSuspend(ctx context.Context, namespace models.NamespaceSpec, jobName string, suspend bool) error {
+	if strings.TrimSpace(jobName) == "" {
+		return ErrEmptyJobName
+	}
+	bucket, err := s.bucketFac.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	blobKey := airflow2.PathFromJobName(JobsDir, namespace.ID.String(), jobName, JobsExtension)
+	contents, err := bucket.ReadAll(ctx, blobKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read cron job of %s", jobName)
+	}
+
+	var manifest yaml.Node
+	if err := yaml.Unmarshal(contents, &manifest); err != nil {
+		return errors.Wrapf(err, "failed to parse cron job of %s", jobName)
+	}
+	suspendNode := mappingValue(mappingValue(manifest.Content[0], "spec"), "suspend")
+	if suspendNode == nil {
+		return errors.Errorf("cron job of %s has no suspend field", jobName)
+	}
+	if err := suspendNode.Encode(suspend); err != nil {
+		return err
+	}
+
+	// keep indentation same as the compiled template
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&manifest); err != nil {
+		return err
+	}
+	return bucket.WriteAll(ctx, blobKey, buf.Bytes(), nil)
+}
+
+func (s *scheduler) getJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time,
+	endDate time.Time) ([]models.JobStatus, error) {
+	jobSpec, _, err := s.projectJobSpecRepoFac.New(projectSpec).GetByName(ctx, jobName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find job %s", jobName)
+	}
+
+	jobRuns, err := s.jobRunRepoFac.New().GetByJob(ctx, jobSpec.ID, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch runs of job %s", jobName)
+	}
+
+	var jobStatus []models.JobStatus
+	for _, jobRun := range jobRuns {
+		jobStatus = append(jobStatus, models.JobStatus{
+			ScheduledAt: jobRun.ScheduledAt,
+			State:       runState(jobSpec, jobRun),
+		})
+	}
+	return jobStatus, nil
+}
+
+func (s *scheduler) notifyProgress(po progress.Observer, event progress.Event) {
+	if po == nil {
+		return
+	}
+	po.Notify(event)
+}
+
+// runState derives state of a run from its instances as nothing updates the
+// run itself, pods report status of every instance once it finishes.
+// Instances execute one after another so the run is successful once every
+// hook and the task has registered an instance and succeeded. Only the latest
+// attempt of an instance is considered
+func runState(jobSpec models.JobSpec, jobRun models.JobRun) models.JobRunState {
+	switch jobRun.Status {
+	case models.RunStateSuccess, models.RunStateFailed, models.RunStateCancelled:
+		return jobRun.Status
+	}
+	if len(jobRun.Instances) == 0 {
+		return models.RunStatePending
+	}
+
+	expectedInstances := 1
+	for _, hook := range jobSpec.Hooks {
+		if hook.Unit.Info().HookType != models.HookTypeFail {
+			expectedInstances++
+		}
+	}
+	latestAttempts := map[string]models.InstanceSpec{}
+	for _, instance := range jobRun.Instances {
+		key := instance.Type.String() + "/" + instance.Name
+		if latest, ok := latestAttempts[key]; !ok || instance.Attempt > latest.Attempt {
+			latestAttempts[key] = instance
+		}
+	}
+	succeeded := 0
+	for _, instance := range latestAttempts {
+		switch instance.Status {
+		case models.RunStateFailed:
+			return models.RunStateFailed
+		case models.RunStateSuccess:
+			succeeded++
+		}
+	}
+	if succeeded >= expectedInstances {
+		return models.RunStateSuccess
+	}
+	return models.RunStateRunning
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for idx := 0; idx+1 < len(node.Content); idx += 2 {
+		if node.Content[idx].Value == key {
+			return node.Content[idx+1]
+		}
+	}
+	return nil
+}
+
+func NewScheduler(bf airflow2.BucketFactory, compiler models.JobCompiler, jobRunRepoFac RunRepoFactory,
+	projectJobSpecRepoFac ProjectJobSpecRepoFactory) *scheduler {
+	return &scheduler{
+		bucketFac:             bf,
+		compiler:              compiler,
+		jobRunRepoFac:         jobRunRepoFac,
+		projectJobSpecRepoFac: projectJobSpecRepoFac,
+	}
+}
@@ -0,0 +1,247 @@
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/ext/scheduler/airflow2"
+	"github.com/odpf/optimus/ext/scheduler/kubernetes"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	tmock "github.com/stretchr/testify/mock"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+)
+
+type MockedBucketFactory struct {
+	tmock.Mock
+}
+
+func (m *MockedBucketFactory) New(ctx context.Context, proj models.ProjectSpec) (airflow2.Bucket, error) {
+	args := m.Called(ctx, proj)
+	return args.Get(0).(airflow2.Bucket), args.Error(1)
+}
+
+// MockedBucket keeps the in memory bucket readable after scheduler closes it
+type MockedBucket struct {
+	*blob.Bucket
+}
+
+func (m *MockedBucket) Close() error {
+	return nil
+}
+
+type MockedCompiler struct {
+	tmock.Mock
+}
+
+func (srv *MockedCompiler) Compile(template []byte, namespace models.NamespaceSpec, jobSpec models.JobSpec) (models.Job, error) {
+	args := srv.Called(template, namespace, jobSpec)
+	return args.Get(0).(models.Job), args.Error(1)
+}
+
+func TestKubernetes(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		Name: "proj-name",
+		Config: map[string]string{
+			models.ProjectStoragePathKey: "gs://mybucket/hello",
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "local-namespace",
+		ProjectSpec: projectSpec,
+	}
+
+	t.Run("DeployJobs", func(t *testing.T) {
+		t.Run("should upload compiled cron jobs to the bucket", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{inMemBlob}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			jobSpec := models.JobSpec{
+				Name: "sample_select",
+			}
+			compiledJob := models.Job{
+				Name:     jobSpec.Name,
+				Contents: []byte("kind: CronJob"),
+			}
+			compiler := new(MockedCompiler)
+			defer compiler.AssertExpectations(t)
+
+			scheduler := kubernetes.NewScheduler(mockBucketFac, compiler, nil, nil)
+			compiler.On("Compile", scheduler.GetTemplate(), namespaceSpec, jobSpec).Return(compiledJob, nil)
+			err := scheduler.DeployJobs(ctx, namespaceSpec, []models.JobSpec{jobSpec}, nil)
+			assert.Nil(t, err)
+
+			storedBytes, err := inMemBlob.ReadAll(ctx, airflow2.PathFromJobName(kubernetes.JobsDir, namespaceSpec.ID.String(),
+				jobSpec.Name, kubernetes.JobsExtension))
+			assert.Nil(t, err)
+			assert.Equal(t, compiledJob.Contents, storedBytes)
+		})
+	})
+	t.Run("Pause", func(t *testing.T) {
+		blobKey := airflow2.PathFromJobName(kubernetes.JobsDir, namespaceSpec.ID.String(), "sample_select", kubernetes.JobsExtension)
+		t.Run("should suspend the stored cron job", func(t *testing.T) {
+			inMemBlob := memblob.OpenBucket(nil)
+			_ = inMemBlob.WriteAll(ctx, blobKey, []byte(`# Code generated by optimus dev. DO NOT EDIT.
+apiVersion: batch/v1
+kind: CronJob
+spec:
+  schedule: "* * * * *"
+  suspend: false
+`), nil)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{inMemBlob}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			scheduler := kubernetes.NewScheduler(mockBucketFac, nil, nil, nil)
+			err := scheduler.Pause(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+
+			storedBytes, err := inMemBlob.ReadAll(ctx, blobKey)
+			assert.Nil(t, err)
+			assert.Equal(t, `# Code generated by optimus dev. DO NOT EDIT.
+apiVersion: batch/v1
+kind: CronJob
+spec:
+  schedule: "* * * * *"
+  suspend: true
+`, string(storedBytes))
+		})
+		t.Run("should fail if cron job is not deployed", func(t *testing.T) {
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{memblob.OpenBucket(nil)}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			scheduler := kubernetes.NewScheduler(mockBucketFac, nil, nil, nil)
+			err := scheduler.Pause(ctx, namespaceSpec, "sample_select")
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("Resume", func(t *testing.T) {
+		t.Run("should unsuspend the stored cron job", func(t *testing.T) {
+			blobKey := airflow2.PathFromJobName(kubernetes.JobsDir, namespaceSpec.ID.String(), "sample_select", kubernetes.JobsExtension)
+			inMemBlob := memblob.OpenBucket(nil)
+			_ = inMemBlob.WriteAll(ctx, blobKey, []byte("kind: CronJob\nspec:\n  suspend: true\n"), nil)
+
+			mockBucketFac := new(MockedBucketFactory)
+			mockBucketFac.On("New", ctx, projectSpec).Return(&MockedBucket{inMemBlob}, nil)
+			defer mockBucketFac.AssertExpectations(t)
+
+			scheduler := kubernetes.NewScheduler(mockBucketFac, nil, nil, nil)
+			err := scheduler.Resume(ctx, namespaceSpec, "sample_select")
+			assert.Nil(t, err)
+
+			storedBytes, err := inMemBlob.ReadAll(ctx, blobKey)
+			assert.Nil(t, err)
+			assert.Equal(t, "kind: CronJob\nspec:\n  suspend: false\n", string(storedBytes))
+		})
+	})
+	t.Run("GetJobRunStatus", func(t *testing.T) {
+		t.Run("should derive status of runs from their registered instances", func(t *testing.T) {
+			hookUnit := new(mock.BasePlugin)
+			hookUnit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name:     "transporter",
+				HookType: models.HookTypePost,
+			}, nil)
+			failHookUnit := new(mock.BasePlugin)
+			failHookUnit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name:     "notify",
+				HookType: models.HookTypeFail,
+			}, nil)
+			jobSpec := models.JobSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "sample_select",
+				Hooks: []models.JobSpecHook{
+					{Unit: &models.Plugin{Base: hookUnit}},
+					{Unit: &models.Plugin{Base: failHookUnit}},
+				},
+			}
+			startDate := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+			endDate := time.Date(2021, 11, 7, 0, 0, 0, 0, time.UTC)
+			jobRuns := []models.JobRun{
+				{
+					ScheduledAt: time.Date(2021, 11, 1, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStatePending,
+					Instances: []models.InstanceSpec{
+						{Name: "bq", Type: models.InstanceTypeTask, Status: models.RunStateSuccess},
+						{Name: "transporter", Type: models.InstanceTypeHook, Status: models.RunStateSuccess},
+					},
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 2, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStatePending,
+					Instances: []models.InstanceSpec{
+						{Name: "bq", Type: models.InstanceTypeTask, Status: models.RunStateFailed},
+					},
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStatePending,
+					Instances: []models.InstanceSpec{
+						{Name: "bq", Type: models.InstanceTypeTask, Status: models.RunStateSuccess},
+						{Name: "transporter", Type: models.InstanceTypeHook, Status: models.RunStateRunning},
+					},
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 4, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStatePending,
+				},
+				{
+					ScheduledAt: time.Date(2021, 11, 5, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStateCancelled,
+				},
+				{
+					// task failed on first attempt and succeeded when retried
+					ScheduledAt: time.Date(2021, 11, 6, 2, 0, 0, 0, time.UTC),
+					Status:      models.RunStatePending,
+					Instances: []models.InstanceSpec{
+						{Name: "bq", Type: models.InstanceTypeTask, Status: models.RunStateFailed, Attempt: 1},
+						{Name: "bq", Type: models.InstanceTypeTask, Status: models.RunStateSuccess, Attempt: 2},
+						{Name: "transporter", Type: models.InstanceTypeHook, Status: models.RunStateSuccess, Attempt: 1},
+					},
+				},
+			}
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, jobSpec.ID, startDate, endDate).Return(jobRuns, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := kubernetes.NewScheduler(nil, nil, runRepoFac, projJobSpecRepoFac)
+			status, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobSpec.Name, startDate, endDate, 100)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{ScheduledAt: jobRuns[0].ScheduledAt, State: models.RunStateSuccess},
+				{ScheduledAt: jobRuns[1].ScheduledAt, State: models.RunStateFailed},
+				{ScheduledAt: jobRuns[2].ScheduledAt, State: models.RunStateRunning},
+				{ScheduledAt: jobRuns[3].ScheduledAt, State: models.RunStatePending},
+				{ScheduledAt: jobRuns[4].ScheduledAt, State: models.RunStateCancelled},
+				{ScheduledAt: jobRuns[5].ScheduledAt, State: models.RunStateSuccess},
+			}, status)
+		})
+	})
+	t.Run("Clear", func(t *testing.T) {
+		t.Run("should fail as reruns are not supported", func(t *testing.T) {
+			scheduler := kubernetes.NewScheduler(nil, nil, nil, nil)
+			err := scheduler.Clear(ctx, projectSpec, "sample_select", time.Now(), time.Now())
+			assert.Equal(t, "clearing runs is not supported by kubernetes scheduler", err.Error())
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package prime
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/core/cron"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+// isRunReady checks if a scheduled run can be allocated for execution.
+// It replicates the airflow sensors, every upstream job should have
+// successful runs for all of its schedule ticks falling in the window of
+// this run, window start being exclusive and end inclusive. If job depends
+// on past, previous run of the same job should have succeeded as well.
+// Manual runs are not held back for dependencies.
+// resolved caches job specs with their dependencies resolved, keyed by job id
+func (p *Planner) isRunReady(ctx context.Context, runRepo store.JobRunRepository, namespace models.NamespaceSpec,
+	jobRun models.JobRun, resolved map[uuid.UUID]models.JobSpec) (bool, error) {
+	if jobRun.Trigger != models.TriggerSchedule {
+		return true, nil
+	}
+
+	// only the scheduled runs count as past, manual and replay runs don't
+	// hold back the next tick
+	if jobRun.Spec.Behavior.DependsOnPast {
+		prevRun, _, err := runRepo.GetPrevious(ctx, jobRun.Spec.ID, jobRun.ScheduledAt, models.TriggerSchedule)
+		if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+			return false, errors.Wrapf(err, "failed to find previous run of job %s", jobRun.Spec.Name)
+		}
+		if err == nil && prevRun.Status != models.RunStateSuccess {
+			return false, nil
+		}
+	}
+
+	jobSpec, ok := resolved[jobRun.Spec.ID]
+	if !ok {
+		var err error
+		jobSpec, err = p.dependencyResolver.Resolve(ctx, namespace.ProjectSpec, jobRun.Spec, nil)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to resolve dependencies of job %s", jobRun.Spec.Name)
+		}
+		resolved[jobRun.Spec.ID] = jobSpec
+	}
+
+	// offset and truncation of task window are ignored while looking
+	// for upstream runs
+	window := models.JobSpecTaskWindow{
+		Size: jobSpec.Task.Window.Size,
+	}
+	windowStart, windowEnd := window.GetStart(jobRun.ScheduledAt), window.GetEnd(jobRun.ScheduledAt)
+	for _, dependency := range jobSpec.Dependencies {
+		if dependency.Job == nil || dependency.Type == models.JobSpecDependencyTypeExtra {
+			continue
+		}
+		ok, err := p.isUpstreamSuccessful(ctx, runRepo, *dependency.Job, windowStart, windowEnd)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// isUpstreamSuccessful checks if scheduled runs of upstream job between
+// provided window have finished successfully. Only the ticks upstream is
+// scheduled for are expected, ticks before its start date or after its end
+// date never get a run. Paused upstream doesn't get runs either and it is
+// not known for how long it will stay paused, so it doesn't hold the
+// downstream runs back
+func (p *Planner) isUpstreamSuccessful(ctx context.Context, runRepo store.JobRunRepository, upstream models.JobSpec,
+	windowStart, windowEnd time.Time) (bool, error) {
+	if upstream.Schedule.Interval == "" {
+		return true, nil
+	}
+	if upstream.Paused {
+		p.l.Debug("ignoring paused upstream job", "job name", upstream.Name)
+		return true, nil
+	}
+	schd, err := cron.ParseCronSchedule(upstream.Schedule.Interval)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse schedule of upstream job %s", upstream.Name)
+	}
+
+	upstreamRuns, err := runRepo.GetByJob(ctx, upstream.ID, windowStart, windowEnd)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to fetch runs of upstream job %s", upstream.Name)
+	}
+	succeeded := map[int64]bool{}
+	for _, upstreamRun := range upstreamRuns {
+		if upstreamRun.Trigger == models.TriggerSchedule && upstreamRun.Status == models.RunStateSuccess {
+			succeeded[upstreamRun.ScheduledAt.Unix()] = true
+		}
+	}
+
+	// cron ticks are strictly after the provided time, step back a bit
+	// so start date itself can be a tick
+	cursor := windowStart
+	if startDate := upstream.Schedule.StartDate.Add(-time.Second); startDate.After(cursor) {
+		cursor = startDate
+	}
+	lastTick := windowEnd
+	if upstream.Schedule.EndDate != nil && upstream.Schedule.EndDate.Before(lastTick) {
+		lastTick = *upstream.Schedule.EndDate
+	}
+	for tick := schd.Next(cursor); !tick.After(lastTick); tick = schd.Next(tick) {
+		if !succeeded[tick.Unix()] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
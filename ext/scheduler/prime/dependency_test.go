@@ -0,0 +1,248 @@
+package prime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlannerDependency(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "ns",
+		ProjectSpec: projectSpec,
+	}
+	nowFn := func() time.Time { return time.Date(2021, 11, 3, 12, 0, 0, 0, time.UTC) }
+
+	upstreamSpec := models.JobSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "upstream",
+		Schedule: models.JobSpecSchedule{
+			Interval: "0 */12 * * *",
+		},
+	}
+	jobSpec := models.JobSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "downstream",
+		Task: models.JobSpecTask{
+			Window: models.JobSpecTaskWindow{
+				Size: time.Hour * 24,
+			},
+		},
+	}
+	resolvedSpec := jobSpec
+	resolvedSpec.Dependencies = map[string]models.JobSpecDependency{
+		upstreamSpec.Name: {
+			Job:     &upstreamSpec,
+			Project: &projectSpec,
+			Type:    models.JobSpecDependencyTypeIntra,
+		},
+	}
+	scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+	jobRun := models.JobRun{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Spec:        jobSpec,
+		Trigger:     models.TriggerSchedule,
+		Status:      models.RunStatePending,
+		ScheduledAt: scheduledAt,
+	}
+	windowStart := scheduledAt.Add(-time.Hour * 24)
+
+	t.Run("isRunReady", func(t *testing.T) {
+		t.Run("should not hold manual runs", func(t *testing.T) {
+			manualRun := jobRun
+			manualRun.Trigger = models.TriggerManual
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, new(mock.JobRunRepository), namespaceSpec, manualRun, map[uuid.UUID]models.JobSpec{})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should be ready if all upstream runs in window have succeeded", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, upstreamSpec.ID, windowStart, scheduledAt).Return([]models.JobRun{
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: windowStart,
+				},
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: windowStart.Add(time.Hour * 12),
+				},
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: scheduledAt,
+				},
+			}, nil)
+			defer runRepo.AssertExpectations(t)
+
+			depResolver := new(mock.DependencyResolver)
+			depResolver.On("Resolve", ctx, projectSpec, jobSpec, nil).Return(resolvedSpec, nil).Once()
+			defer depResolver.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, depResolver, nil, nil, nil, nil, nowFn)
+			resolved := map[uuid.UUID]models.JobSpec{}
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, resolved)
+			assert.Nil(t, err)
+			assert.True(t, ready)
+
+			// resolved specs are reused
+			ready, err = planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, resolved)
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should not be ready if any upstream run in window is not successful", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, upstreamSpec.ID, windowStart, scheduledAt).Return([]models.JobRun{
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: windowStart.Add(time.Hour * 12),
+				},
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateRunning,
+					ScheduledAt: scheduledAt,
+				},
+			}, nil)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: resolvedSpec,
+			})
+			assert.Nil(t, err)
+			assert.False(t, ready)
+		})
+		withUpstream := func(upstream models.JobSpec) models.JobSpec {
+			spec := jobSpec
+			spec.Dependencies = map[string]models.JobSpecDependency{
+				upstream.Name: {
+					Job:     &upstream,
+					Project: &projectSpec,
+					Type:    models.JobSpecDependencyTypeIntra,
+				},
+			}
+			return spec
+		}
+		t.Run("should only expect upstream runs on or after its start date", func(t *testing.T) {
+			upstream := upstreamSpec
+			upstream.Schedule.StartDate = scheduledAt
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, upstreamSpec.ID, windowStart, scheduledAt).Return([]models.JobRun{
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: scheduledAt,
+				},
+			}, nil)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: withUpstream(upstream),
+			})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should not expect upstream runs after its end date", func(t *testing.T) {
+			upstream := upstreamSpec
+			endDate := windowStart.Add(time.Hour * 12)
+			upstream.Schedule.EndDate = &endDate
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, upstreamSpec.ID, windowStart, scheduledAt).Return([]models.JobRun{
+				{
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: windowStart.Add(time.Hour * 12),
+				},
+			}, nil)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: withUpstream(upstream),
+			})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should be ready if upstream has ended before the window", func(t *testing.T) {
+			upstream := upstreamSpec
+			endDate := windowStart.Add(-time.Hour)
+			upstream.Schedule.EndDate = &endDate
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, upstreamSpec.ID, windowStart, scheduledAt).Return([]models.JobRun{}, nil)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: withUpstream(upstream),
+			})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should not be held back by paused upstream", func(t *testing.T) {
+			upstream := upstreamSpec
+			upstream.Paused = true
+
+			runRepo := new(mock.JobRunRepository)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, jobRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: withUpstream(upstream),
+			})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+		t.Run("should not be ready if previous run failed and job depends on past", func(t *testing.T) {
+			pastRun := jobRun
+			pastRun.Spec.Behavior.DependsOnPast = true
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetPrevious", ctx, jobSpec.ID, scheduledAt, models.TriggerSchedule).Return(models.JobRun{
+				Status:      models.RunStateFailed,
+				ScheduledAt: windowStart,
+			}, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, pastRun, map[uuid.UUID]models.JobSpec{})
+			assert.Nil(t, err)
+			assert.False(t, ready)
+		})
+		t.Run("should be ready if there is no previous run and job depends on past", func(t *testing.T) {
+			pastRun := jobRun
+			pastRun.Spec.Behavior.DependsOnPast = true
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetPrevious", ctx, jobSpec.ID, scheduledAt, models.TriggerSchedule).Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound)
+			defer runRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			ready, err := planner.isRunReady(ctx, runRepo, namespaceSpec, pastRun, map[uuid.UUID]models.JobSpec{
+				jobSpec.ID: jobSpec,
+			})
+			assert.Nil(t, err)
+			assert.True(t, ready)
+		})
+	})
+}
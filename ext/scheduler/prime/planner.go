@@ -1,25 +1,33 @@
 package prime
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/odpf/salt/log"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/serf/serf"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster"
+	"github.com/odpf/optimus/core/cron"
 	"github.com/odpf/optimus/core/gossip"
+	"github.com/odpf/optimus/job"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/odpf/optimus/utils"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
 
 const (
-	// PeerPoolSize is fixed at the moment, should be configurable
-	// this dictates how many jobs can be executed by a single optimus peer
-	// at a time
+	// PeerPoolSize dictates how many jobs can be executed by a single optimus
+	// peer at a time if the peer doesn't advertise its capacity
 	PeerPoolSize = 20
 
 	SleepTime = time.Second * 10
@@ -28,9 +36,9 @@ const (
 	// running state more than provided time
 	InstanceRunTimeout = time.Hour * 4
 
-	// NodeJobRunTimeout will clear job run move it back to pending state to be
-	// handled by another/same peer again
-	NodeJobRunTimeout = time.Hour * 1
+	// LogFlushInterval is how often output of an instance under execution
+	// is persisted, this is the delay in following live logs
+	LogFlushInterval = time.Second * 5
 )
 
 type ClusterManager interface {
@@ -41,16 +49,54 @@ type ClusterManager interface {
 	GetLocalMember() serf.Member
 }
 
+// ProjectRepoFactory is used to find all the registered projects
+type ProjectRepoFactory interface {
+	New() store.ProjectRepository
+}
+
+// NamespaceRepoFactory is used to find all the namespaces of a project
+type NamespaceRepoFactory interface {
+	New(proj models.ProjectSpec) store.NamespaceRepository
+}
+
+// JobSpecRepoFactory is used to find deployed job specs of a namespace
+type JobSpecRepoFactory interface {
+	New(namespace models.NamespaceSpec) job.SpecRepository
+}
+
 // Planners creates an execution plan by monitoring
 // all the connected peers and assigning
 type Planner struct {
 	l log.Logger
 
-	clusterManager  ClusterManager
-	jobRunRepoFac   RunRepoFactory
-	instanceRepoFac InstanceRepoFactory
-	executor        models.ExecutorUnit
-	uuidProvider    utils.UUIDProvider
+	clusterManager     ClusterManager
+	jobRunRepoFac      RunRepoFactory
+	instanceRepoFac    InstanceRepoFactory
+	projectRepoFac     ProjectRepoFactory
+	namespaceRepoFac   NamespaceRepoFactory
+	jobSpecRepoFac     JobSpecRepoFactory
+	dependencyResolver job.DependencyResolver
+	runService         models.RunService
+	executor           models.ExecutorUnit
+	instanceLogRepo    store.InstanceLogRepository
+	uuidProvider       utils.UUIDProvider
+
+	// triggers are the kind of job runs this planner is responsible
+	// for executing
+	triggers []models.JobRunTrigger
+
+	// lastMaterialized tracks the schedule time of the latest run
+	// created for a job so ticks are not evaluated again on every loop
+	lastMaterialized map[uuid.UUID]time.Time
+
+	// runNamespaces caches namespace of runs which are pending or under
+	// execution, used to enforce namespace concurrency
+	runNamespaces map[uuid.UUID]models.NamespaceSpec
+
+	// cancelledRuns tracks runs allocated to this peer which are asked to
+	// be cancelled, executions of these runs are not continued any further
+	cancelledRuns map[uuid.UUID]bool
+	mu            *sync.Mutex
 
 	wg      *sync.WaitGroup
 	errChan chan error
@@ -63,9 +109,14 @@ func (p *Planner) Init(ctx context.Context) error {
 			p.l.Error("planner error accumulator", "error", err)
 		}
 	}()
+	if p.projectRepoFac != nil {
+		go p.leaderRunMaterialization(ctx)
+	}
 	go p.leaderJobAllocation(ctx)
 	go p.leaderJobReconcile(ctx)
+	go p.leaderJobCancellation(ctx)
 	go p.peerJobExecution(ctx)
+	go p.peerJobCancellation(ctx)
 	return nil
 }
 
@@ -74,6 +125,163 @@ func (p *Planner) Close() error {
 	return nil
 }
 
+// leaderRunMaterialization creates scheduled runs of all the deployed jobs
+// as their cron intervals elapse so that they can be allocated to peers
+func (p *Planner) leaderRunMaterialization(ctx context.Context) {
+	p.wg.Add(1)
+	defer p.wg.Done()
+	loopIdx := 0
+	for {
+		if !p.clusterManager.IsLeader() {
+			time.Sleep(SleepTime)
+			continue
+		}
+
+		if err := p.materializeRuns(ctx); err != nil {
+			p.errChan <- err
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			loopIdx++
+			time.Sleep(SleepTime)
+		}
+	}
+}
+
+// materializeRuns walks through job specs of every registered project and
+// saves a pending run for each of their schedule ticks which has elapsed
+// but is not stored yet
+func (p *Planner) materializeRuns(ctx context.Context) error {
+	projects, err := p.projectRepoFac.New().GetAll(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch projects")
+	}
+
+	var materializeErrors error
+	runRepo := p.jobRunRepoFac.New()
+	for _, proj := range projects {
+		namespaces, err := p.namespaceRepoFac.New(proj).GetAll(ctx)
+		if err != nil {
+			materializeErrors = multierror.Append(materializeErrors, errors.Wrapf(err, "failed to fetch namespaces of %s", proj.Name))
+			continue
+		}
+		for _, namespace := range namespaces {
+			jobSpecs, err := p.jobSpecRepoFac.New(namespace).GetAll(ctx)
+			if err != nil {
+				materializeErrors = multierror.Append(materializeErrors, errors.Wrapf(err, "failed to fetch jobs of %s", namespace.Name))
+				continue
+			}
+			for _, jobSpec := range jobSpecs {
+				// a misconfigured job should not stop others from being scheduled
+				if err := p.materializeJobRuns(ctx, runRepo, namespace, jobSpec); err != nil {
+					materializeErrors = multierror.Append(materializeErrors, err)
+				}
+			}
+		}
+	}
+	return materializeErrors
+}
+
+// materializeJobRuns creates runs for the schedule ticks of a job which falls
+// between its start date and now(or end date if that is earlier). If catch up
+// is disabled only the latest elapsed tick is created. Paused jobs are
+// skipped, once resumed their missed ticks are created as per catch up
+func (p *Planner) materializeJobRuns(ctx context.Context, runRepo store.JobRunRepository,
+	namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
+	if jobSpec.Schedule.Interval == "" || jobSpec.Paused {
+		return nil
+	}
+	schd, err := cron.ParseCronSchedule(jobSpec.Schedule.Interval)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse schedule of job %s", jobSpec.Name)
+	}
+
+	windowEnd := p.now()
+	if jobSpec.Schedule.EndDate != nil && jobSpec.Schedule.EndDate.Before(windowEnd) {
+		windowEnd = *jobSpec.Schedule.EndDate
+	}
+	// cron ticks are strictly after the provided time, step back a bit
+	// so start date itself can be a tick
+	cursor := jobSpec.Schedule.StartDate.Add(-time.Second)
+	if last, ok := p.lastMaterialized[jobSpec.ID]; ok && last.After(cursor) {
+		cursor = last
+	}
+
+	var ticks []time.Time
+	if jobSpec.Behavior.CatchUp {
+		for tick := schd.Next(cursor); !tick.After(windowEnd); tick = schd.Next(tick) {
+			ticks = append(ticks, tick)
+		}
+	} else if tick, ok := lastTick(schd, cursor, windowEnd); ok {
+		ticks = []time.Time{tick}
+	}
+
+	for _, tick := range ticks {
+		_, _, err := runRepo.GetByScheduledAt(ctx, jobSpec.ID, tick)
+		if err == nil {
+			// already materialized
+			p.lastMaterialized[jobSpec.ID] = tick
+			continue
+		}
+		if !errors.Is(err, store.ErrResourceNotFound) {
+			return errors.Wrapf(err, "failed to find run of job %s", jobSpec.Name)
+		}
+
+		if err := runRepo.Save(ctx, namespace, models.JobRun{
+			Spec:        jobSpec,
+			Trigger:     models.TriggerSchedule,
+			Status:      models.RunStatePending,
+			ScheduledAt: tick,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to save run of job %s", jobSpec.Name)
+		}
+		p.l.Debug("materialized scheduled run", "job name", jobSpec.Name, "scheduled at", tick)
+		p.lastMaterialized[jobSpec.ID] = tick
+	}
+	return nil
+}
+
+// lastTick returns the latest tick of schedule after cursor which is not
+// after end. Instead of walking every tick since cursor, it looks back from
+// end by an interval of the schedule, cron intervals are not constant so the
+// look back is doubled till a tick is found or cursor is reached
+func lastTick(schd *cron.ScheduleSpec, cursor, end time.Time) (time.Time, bool) {
+	nextTick := schd.Next(end)
+	lookBack := schd.Next(nextTick).Sub(nextTick)
+	for {
+		from := end.Add(-lookBack)
+		if lookBack <= 0 || !from.After(cursor) {
+			from = cursor
+		}
+		var last time.Time
+		found := false
+		for tick := schd.Next(from); !tick.After(end); tick = schd.Next(tick) {
+			last, found = tick, true
+		}
+		if found || from.Equal(cursor) {
+			return last, found
+		}
+		lookBack *= 2
+	}
+}
+
+// getRunsByStatus returns runs of all the triggers this planner is
+// responsible for in provided states
+func (p *Planner) getRunsByStatus(ctx context.Context, runRepo store.JobRunRepository, statuses ...models.JobRunState) ([]models.JobRun, error) {
+	var jobRuns []models.JobRun
+	for _, trigger := range p.triggers {
+		triggerRuns, err := runRepo.GetByTrigger(ctx, trigger, statuses...)
+		if err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, triggerRuns...)
+	}
+	return jobRuns, nil
+}
+
 func (p *Planner) leaderJobAllocation(ctx context.Context) {
 	p.wg.Add(1)
 	defer p.wg.Done()
@@ -84,12 +292,22 @@ func (p *Planner) leaderJobAllocation(ctx context.Context) {
 			continue
 		}
 
-		allocNodeID, allocRunIDs, err := p.getJobAllocations(ctx)
+		if err := p.releaseDepartedPeers(ctx); err != nil {
+			p.errChan <- err
+		}
+
+		allocations, err := p.getJobAllocations(ctx)
 		if err != nil {
 			p.errChan <- err
 			return
 		}
-		if len(allocRunIDs) > 0 {
+		var allocNodeIDs []string
+		for nodeID := range allocations {
+			allocNodeIDs = append(allocNodeIDs, nodeID)
+		}
+		sort.Strings(allocNodeIDs)
+		for _, allocNodeID := range allocNodeIDs {
+			allocRunIDs := allocations[allocNodeID]
 			var stringRunIDs []string
 			for _, ri := range allocRunIDs {
 				stringRunIDs = append(stringRunIDs, ri.String())
@@ -114,9 +332,19 @@ func (p *Planner) leaderJobAllocation(ctx context.Context) {
 			}
 
 			// once the command is committed to raft log, we need to update the job state
-			// from pending to accepted
+			// from pending to accepted. A run cancelled in the meantime is marked
+			// cancelling instead so that the peer it got allocated to stops it
+			runRepo := p.jobRunRepoFac.New()
 			for _, runID := range allocRunIDs {
-				if err := p.jobRunRepoFac.New().UpdateStatus(ctx, runID, models.RunStateAccepted); err != nil {
+				accepted, err := runRepo.UpdateStatusFrom(ctx, runID, models.RunStatePending, models.RunStateAccepted)
+				if err != nil {
+					p.errChan <- err
+					return
+				}
+				if accepted {
+					continue
+				}
+				if _, err := runRepo.UpdateStatusFrom(ctx, runID, models.RunStateCancelled, models.RunStateCancelling); err != nil {
 					p.errChan <- err
 					return
 				}
@@ -134,54 +362,225 @@ func (p *Planner) leaderJobAllocation(ctx context.Context) {
 }
 
 // getJobAllocations looks for job runs which are in pending state that means
-// they are not allocated to any peer for execution. This allocation is mostly
-// done based on which node has most capacity available for execution.
-// We assume if in case a node goes down, it will come back up for sure
-// and we will not scale down the cluster once its scaled up. This is a
-// temporary approach and ideally we should timeout jobs which are assigned
-// to jobs which went down and move them back to the pending state list.
-func (p *Planner) getJobAllocations(ctx context.Context) (mostCapNodeID string, runIDs []uuid.UUID, err error) {
-	pendingJobRuns, err := p.jobRunRepoFac.New().GetByTrigger(ctx, models.TriggerManual, models.RunStatePending)
+// they are not allocated to any peer for execution. Runs with higher priority
+// weight are allocated first, older runs first among the equals. Each run goes
+// to the peer which has most free slots as per the capacity it advertises.
+// If namespace of a run has a concurrency limit configured, run waits till
+// executions of the namespace fall under it.
+func (p *Planner) getJobAllocations(ctx context.Context) (map[string][]uuid.UUID, error) {
+	runRepo := p.jobRunRepoFac.New()
+	pendingJobRuns, err := p.getRunsByStatus(ctx, runRepo, models.RunStatePending)
 	if err != nil {
-		return
+		return nil, err
 	}
+	sort.SliceStable(pendingJobRuns, func(i, j int) bool {
+		if pendingJobRuns[i].Spec.Task.Priority != pendingJobRuns[j].Spec.Task.Priority {
+			return pendingJobRuns[i].Spec.Task.Priority > pendingJobRuns[j].Spec.Task.Priority
+		}
+		return pendingJobRuns[i].ScheduledAt.Before(pendingJobRuns[j].ScheduledAt)
+	})
 
-	// find which node has most capacity
-	peerUtilization := map[string]int{}
+	// find free slots of every peer
+	peerSlots := map[string]int{}
 	for _, mem := range p.clusterManager.GetClusterMembers() {
-		// it is possible that the allocation state is empty so we need to initialize
-		// the map with 0 for all cluster members first
-		peerUtilization[mem.Name] = 0
+		if mem.Status != serf.StatusAlive {
+			continue
+		}
+		peerSlots[mem.Name] = peerCapacity(mem)
 	}
+	var activeRunIDs []uuid.UUID
 	currentState := p.clusterManager.GetState()
 	for nodeID, allocationSet := range currentState.Allocation {
 		for _, rawAlloc := range allocationSet.Values() {
 			alloc := rawAlloc.(gossip.StateJob)
 			if alloc.Status == models.RunStateAccepted.String() ||
-				alloc.Status == models.RunStateRunning.String() {
-				peerUtilization[nodeID]++
+				alloc.Status == models.RunStateRunning.String() ||
+				alloc.Status == models.RunStateCancelling.String() {
+				if _, ok := peerSlots[nodeID]; ok {
+					peerSlots[nodeID]--
+				}
+				if runID, err := uuid.Parse(alloc.UUID); err == nil {
+					activeRunIDs = append(activeRunIDs, runID)
+				}
 			}
 		}
 	}
-	var mostCapSize = PeerPoolSize
-	for nodeID, utilization := range peerUtilization {
-		if utilization < mostCapSize {
-			mostCapNodeID = nodeID
-			mostCapSize = utilization
+
+	// forget namespaces of runs which are not relevant anymore
+	knownRunIDs := map[uuid.UUID]bool{}
+	for _, runID := range activeRunIDs {
+		knownRunIDs[runID] = true
+	}
+	for _, pendingRun := range pendingJobRuns {
+		knownRunIDs[pendingRun.ID] = true
+	}
+	for runID := range p.runNamespaces {
+		if !knownRunIDs[runID] {
+			delete(p.runNamespaces, runID)
 		}
 	}
-	// cluster is full at the moment
-	if mostCapNodeID == "" {
-		return
+
+	// runs under execution count towards concurrency of their namespace
+	namespaceUsage := map[uuid.UUID]int{}
+	for _, runID := range activeRunIDs {
+		namespace, err := p.getRunNamespace(ctx, runRepo, runID)
+		if err != nil {
+			return nil, err
+		}
+		namespaceUsage[namespace.ID]++
 	}
 
-	for idx, pendingRun := range pendingJobRuns {
-		runIDs = append(runIDs, pendingRun.ID)
-		if idx+mostCapSize > PeerPoolSize {
+	allocations := map[string][]uuid.UUID{}
+	resolvedSpecs := map[uuid.UUID]models.JobSpec{}
+	for _, pendingRun := range pendingJobRuns {
+		nodeID := mostFreePeer(peerSlots)
+		if nodeID == "" {
+			// cluster is full at the moment
 			break
 		}
+
+		namespace, err := p.getRunNamespace(ctx, runRepo, pendingRun.ID)
+		if err != nil {
+			p.l.Warn("failed to find namespace of run", "run id", pendingRun.ID, "error", err)
+			continue
+		}
+		if limit, ok := namespaceConcurrency(namespace); ok && namespaceUsage[namespace.ID] >= limit {
+			continue
+		}
+
+		ready, readyErr := p.isRunReady(ctx, runRepo, namespace, pendingRun, resolvedSpecs)
+		if readyErr != nil {
+			// a run which can't be checked should not block others
+			p.l.Warn("failed to check dependencies of run", "run id", pendingRun.ID, "error", readyErr)
+			continue
+		}
+		if !ready {
+			continue
+		}
+		allocations[nodeID] = append(allocations[nodeID], pendingRun.ID)
+		peerSlots[nodeID]--
+		namespaceUsage[namespace.ID]++
 	}
-	return
+	return allocations, nil
+}
+
+// releaseDepartedPeers looks for peers holding allocations which are not
+// alive members of the cluster anymore, either because they left or failed.
+// Their runs are released from the cluster state and the ones which were
+// accepted or under execution are moved back to pending so that they can be
+// allocated to another peer. Runs being cancelled are left to cancelRuns
+// which marks them cancelled once they are not allocated anymore
+func (p *Planner) releaseDepartedPeers(ctx context.Context) error {
+	alivePeers := map[string]bool{}
+	for _, mem := range p.clusterManager.GetClusterMembers() {
+		if mem.Status == serf.StatusAlive {
+			alivePeers[mem.Name] = true
+		}
+	}
+
+	currentState := p.clusterManager.GetState()
+	var departedNodeIDs []string
+	for nodeID := range currentState.Allocation {
+		if !alivePeers[nodeID] {
+			departedNodeIDs = append(departedNodeIDs, nodeID)
+		}
+	}
+	sort.Strings(departedNodeIDs)
+
+	runRepo := p.jobRunRepoFac.New()
+	var releaseErrors error
+	for _, nodeID := range departedNodeIDs {
+		var runIDs []string
+		var resetRunIDs []uuid.UUID
+		for _, rawAlloc := range currentState.Allocation[nodeID].Values() {
+			alloc := rawAlloc.(gossip.StateJob)
+			runIDs = append(runIDs, alloc.UUID)
+			if alloc.Status != models.RunStateAccepted.String() &&
+				alloc.Status != models.RunStateRunning.String() {
+				continue
+			}
+			runID, err := uuid.Parse(alloc.UUID)
+			if err != nil {
+				p.l.Warn("invalid run id in allocation", "run id", alloc.UUID, "error", err)
+				continue
+			}
+			resetRunIDs = append(resetRunIDs, runID)
+		}
+		if len(runIDs) == 0 {
+			continue
+		}
+
+		// propagate this message to whole cluster
+		payload, err := proto.Marshal(&pb.CommandReleaseJob{
+			PeerId: nodeID,
+			RunIds: runIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if err := p.clusterManager.ApplyCommand(&pb.CommandLog{
+			Type:    pb.CommandLog_COMMAND_TYPE_RELEASE_JOB,
+			Payload: payload,
+		}); err != nil {
+			return err
+		}
+		p.l.Info("released runs of departed peer", "nodeID", nodeID, "run ids", runIDs)
+
+		// once released from the peer, runs are ready to be allocated again
+		for _, runID := range resetRunIDs {
+			if err := runRepo.Clear(ctx, runID); err != nil {
+				releaseErrors = multierror.Append(releaseErrors, err)
+			}
+		}
+	}
+	return releaseErrors
+}
+
+// getRunNamespace finds the namespace a run belongs to
+func (p *Planner) getRunNamespace(ctx context.Context, runRepo store.JobRunRepository, runID uuid.UUID) (models.NamespaceSpec, error) {
+	if namespace, ok := p.runNamespaces[runID]; ok {
+		return namespace, nil
+	}
+	_, namespace, err := runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return models.NamespaceSpec{}, errors.Wrapf(err, "failed to find run %s", runID)
+	}
+	p.runNamespaces[runID] = namespace
+	return namespace, nil
+}
+
+// peerCapacity is the number of runs a peer can execute at a time, it is
+// advertised by peers as a serf tag
+func peerCapacity(mem serf.Member) int {
+	capacity, err := strconv.Atoi(mem.Tags[gossip.TagCapacity])
+	if err != nil || capacity < 1 {
+		return PeerPoolSize
+	}
+	return capacity
+}
+
+// mostFreePeer returns the peer with most free slots, empty if
+// all of them are full
+func mostFreePeer(peerSlots map[string]int) string {
+	var freePeer string
+	var freeSlots int
+	for nodeID, slots := range peerSlots {
+		if slots > freeSlots || (slots == freeSlots && slots > 0 && nodeID < freePeer) {
+			freePeer = nodeID
+			freeSlots = slots
+		}
+	}
+	return freePeer
+}
+
+// namespaceConcurrency returns the limit on concurrent runs of a namespace
+// if it is configured
+func namespaceConcurrency(namespace models.NamespaceSpec) (int, bool) {
+	limit, err := strconv.Atoi(namespace.Config[models.NamespaceConcurrencyKey])
+	if err != nil || limit < 1 {
+		return 0, false
+	}
+	return limit, true
 }
 
 // leaderJobReconcile should update the job run state from running to
@@ -199,7 +598,7 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 
 		runRepo := p.jobRunRepoFac.New()
 		// check for non assignment, non terminating states
-		waitingJobs, err := runRepo.GetByTrigger(ctx, models.TriggerManual, models.RunStateAccepted, models.RunStateRunning)
+		waitingJobs, err := p.getRunsByStatus(ctx, runRepo, models.RunStateAccepted, models.RunStateRunning)
 		if err != nil {
 			p.errChan <- err
 			continue
@@ -208,19 +607,7 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 			// check if this run is assigned to a node
 			// if the job is in non terminating, non assignment state and its not
 			// assigned to a node, we must have lost our WAL, mark it to be rescheduled
-			var allocatedNode string
-			for nodeID, allocSet := range p.clusterManager.GetState().Allocation {
-				if allocatedNode != "" {
-					break
-				}
-				for _, rawAlloc := range allocSet.Values() {
-					alloc := rawAlloc.(gossip.StateJob)
-					if alloc.UUID == currentRun.ID.String() {
-						allocatedNode = nodeID
-						break
-					}
-				}
-			}
+			allocatedNode, _ := p.findAllocation(currentRun.ID)
 			if allocatedNode == "" {
 				// move it back to assignment
 				if err := runRepo.Clear(ctx, currentRun.ID); err != nil {
@@ -231,21 +618,7 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 			}
 
 			// check if we need to update the run state inferred from instance states
-			finalState := currentRun.Status
-			instanceStates := map[models.JobRunState]int{}
-			for _, instance := range currentRun.Instances {
-				instanceStates[instance.Status]++
-			}
-
-			if instanceStates[models.RunStateFailed] > 0 {
-				finalState = models.RunStateFailed
-			} else if instanceStates[models.RunStateSuccess] == len(currentRun.Instances) && len(currentRun.Instances) > 0 {
-				finalState = models.RunStateSuccess
-			} else if instanceStates[models.RunStateRunning] > 0 || len(currentRun.Instances) > 0 {
-				// if anyone is in running or no instances created so far for this job run
-				// we should be in running or no node has picked this run yet
-				finalState = models.RunStateRunning
-			}
+			finalState := inferRunState(currentRun)
 
 			if finalState != currentRun.Status {
 				// propagate this message to whole cluster
@@ -286,6 +659,172 @@ func (p *Planner) leaderJobReconcile(ctx context.Context) {
 	}
 }
 
+// findAllocation returns the peer a run is allocated to along with its
+// allocation status, peer is empty if run is not allocated
+func (p *Planner) findAllocation(runID uuid.UUID) (string, string) {
+	for nodeID, allocSet := range p.clusterManager.GetState().Allocation {
+		for _, rawAlloc := range allocSet.Values() {
+			alloc := rawAlloc.(gossip.StateJob)
+			if alloc.UUID == runID.String() {
+				return nodeID, alloc.Status
+			}
+		}
+	}
+	return "", ""
+}
+
+// leaderJobCancellation moves the runs which are asked to be cancelled
+// to cancelled state once the peers executing them have stopped
+func (p *Planner) leaderJobCancellation(ctx context.Context) {
+	p.wg.Add(1)
+	defer p.wg.Done()
+	loopIdx := 0
+	for {
+		if !p.clusterManager.IsLeader() {
+			time.Sleep(SleepTime)
+			continue
+		}
+
+		if err := p.cancelRuns(ctx); err != nil {
+			p.errChan <- err
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			loopIdx++
+			time.Sleep(SleepTime)
+		}
+	}
+}
+
+// cancelRuns propagates cancellation of runs to the peers they are
+// allocated to. A run is marked cancelled right away if no peer has it,
+// else once none of its instances are under execution anymore
+func (p *Planner) cancelRuns(ctx context.Context) error {
+	runRepo := p.jobRunRepoFac.New()
+	cancellingRuns, err := p.getRunsByStatus(ctx, runRepo, models.RunStateCancelling)
+	if err != nil {
+		return err
+	}
+
+	var cancelErrors error
+	for _, currentRun := range cancellingRuns {
+		allocatedNode, allocStatus := p.findAllocation(currentRun.ID)
+		if allocatedNode == "" {
+			if err := runRepo.UpdateStatus(ctx, currentRun.ID, models.RunStateCancelled); err != nil {
+				cancelErrors = multierror.Append(cancelErrors, err)
+			}
+			continue
+		}
+
+		if allocStatus != models.RunStateCancelling.String() {
+			// ask the peer to stop the run
+			payload, err := proto.Marshal(&pb.CommandCancelJob{
+				PeerId: allocatedNode,
+				RunIds: []string{currentRun.ID.String()},
+			})
+			if err != nil {
+				return err
+			}
+			if err := p.clusterManager.ApplyCommand(&pb.CommandLog{
+				Type:    pb.CommandLog_COMMAND_TYPE_CANCEL_JOB,
+				Payload: payload,
+			}); err != nil {
+				return err
+			}
+			p.l.Debug("requested cancellation of run", "run id", currentRun.ID, "nodeID", allocatedNode)
+			continue
+		}
+
+		stopped := true
+		for _, instance := range currentRun.Instances {
+			if instance.Status == models.RunStateAccepted || instance.Status == models.RunStateRunning {
+				stopped = false
+				break
+			}
+		}
+		if !stopped {
+			continue
+		}
+
+		// deallocate the run from peer
+		payload, err := proto.Marshal(&pb.CommandUpdateJob{
+			Patches: []*pb.CommandUpdateJob_Patch{
+				{
+					RunId:  currentRun.ID.String(),
+					Status: models.RunStateCancelled.String(),
+				},
+			},
+			PeerId: allocatedNode,
+		})
+		if err != nil {
+			return err
+		}
+		if err := p.clusterManager.ApplyCommand(&pb.CommandLog{
+			Type:    pb.CommandLog_COMMAND_TYPE_UPDATE_JOB,
+			Payload: payload,
+		}); err != nil {
+			return err
+		}
+		if err := runRepo.UpdateStatus(ctx, currentRun.ID, models.RunStateCancelled); err != nil {
+			cancelErrors = multierror.Append(cancelErrors, err)
+		}
+	}
+	return cancelErrors
+}
+
+// inferRunState derives state of a run from its instances. Run succeeds once
+// task and all of its post hooks have succeeded. If any of pre hooks, task or
+// post hooks fails, run fails once all of its fail hooks have finished.
+// Till then run stays running, or in its current state if no node has picked
+// it so far
+func inferRunState(jobRun models.JobRun) models.JobRunState {
+	if len(jobRun.Instances) == 0 {
+		return jobRun.Status
+	}
+
+	var taskState models.JobRunState
+	hookStates := map[string]models.JobRunState{}
+	for _, instance := range jobRun.Instances {
+		switch instance.Type {
+		case models.InstanceTypeTask:
+			taskState = instance.Status
+		case models.InstanceTypeHook:
+			hookStates[instance.Name] = instance.Status
+		}
+	}
+
+	failed := taskState == models.RunStateFailed
+	postHooksSucceeded := true
+	failHooksFinished := true
+	for _, hook := range jobRun.Spec.Hooks {
+		hookState := hookStates[hook.Unit.Info().Name]
+		switch hook.Unit.Info().HookType {
+		case models.HookTypePre:
+			failed = failed || hookState == models.RunStateFailed
+		case models.HookTypePost:
+			failed = failed || hookState == models.RunStateFailed
+			postHooksSucceeded = postHooksSucceeded && hookState == models.RunStateSuccess
+		case models.HookTypeFail:
+			failHooksFinished = failHooksFinished &&
+				(hookState == models.RunStateSuccess || hookState == models.RunStateFailed)
+		}
+	}
+
+	if failed {
+		if failHooksFinished {
+			return models.RunStateFailed
+		}
+		return models.RunStateRunning
+	}
+	if taskState == models.RunStateSuccess && postHooksSucceeded {
+		return models.RunStateSuccess
+	}
+	return models.RunStateRunning
+}
+
 // peerJobExecution looks for job assigned to this node and executes them
 func (p *Planner) peerJobExecution(ctx context.Context) {
 	p.wg.Add(1)
@@ -331,26 +870,161 @@ func (p *Planner) peerJobExecution(ctx context.Context) {
 	}
 }
 
+// peerJobCancellation looks for runs of this node which are asked to be
+// cancelled and stops their executions
+func (p *Planner) peerJobCancellation(ctx context.Context) {
+	p.wg.Add(1)
+	defer p.wg.Done()
+	loopIdx := 0
+	for {
+		if err := p.stopCancelledRuns(ctx); err != nil {
+			p.errChan <- err
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			loopIdx++
+			time.Sleep(SleepTime)
+		}
+	}
+}
+
+// stopCancelledRuns stops running instances of runs allocated to this node
+// which are asked to be cancelled. Stopped instances finish with a non zero
+// code and are marked failed by the execution, if executor fails to stop an
+// instance it is marked failed here so run can be moved to cancelled
+func (p *Planner) stopCancelledRuns(ctx context.Context) error {
+	localNodeID := p.clusterManager.GetLocalMember().Name
+	currentAllocations, ok := p.clusterManager.GetState().Allocation[localNodeID]
+	if !ok {
+		return nil
+	}
+
+	allocated := map[uuid.UUID]bool{}
+	var stopErrors error
+	for _, rawAlloc := range currentAllocations.Values() {
+		alloc := rawAlloc.(gossip.StateJob)
+		runUUID, err := uuid.Parse(alloc.UUID)
+		if err != nil {
+			stopErrors = multierror.Append(stopErrors, err)
+			continue
+		}
+		allocated[runUUID] = true
+		if alloc.Status != models.RunStateCancelling.String() {
+			continue
+		}
+		p.markRunCancelled(runUUID)
+
+		jobRun, _, err := p.jobRunRepoFac.New().GetByID(ctx, runUUID)
+		if err != nil {
+			stopErrors = multierror.Append(stopErrors, err)
+			continue
+		}
+		for _, instance := range jobRun.Instances {
+			if instance.Status != models.RunStateRunning {
+				continue
+			}
+			p.l.Info("stopping cancelled instance", "job name", jobRun.Spec.Name, "instance name", instance.Name)
+			if err := p.executor.Stop(ctx, models.ExecutorStopRequest{
+				ID:     instance.ID.String(),
+				Signal: "SIGTERM",
+			}); err != nil {
+				p.l.Warn("failed to stop instance, marking it failed", "instance id", instance.ID, "error", err)
+				if err := p.instanceRepoFac.New().UpdateStatus(ctx, instance.ID, models.RunStateFailed); err != nil {
+					stopErrors = multierror.Append(stopErrors, err)
+				}
+			}
+		}
+	}
+
+	// forget cancelled runs which are deallocated
+	p.mu.Lock()
+	for runID := range p.cancelledRuns {
+		if !allocated[runID] {
+			delete(p.cancelledRuns, runID)
+		}
+	}
+	p.mu.Unlock()
+	return stopErrors
+}
+
+func (p *Planner) markRunCancelled(runID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cancelledRuns[runID] = true
+}
+
+// isRunCancelled checks if run being executed by this node is asked to
+// be cancelled
+func (p *Planner) isRunCancelled(runID uuid.UUID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.cancelledRuns[runID]
+}
+
 // executeRun finds all tasks/hooks that belong to this run job spec and
-// execute them in order
+// execute them in order, pre hooks followed by task and then post hooks.
+// If any of them fails, rest are skipped and fail hooks are executed.
+// If run gets cancelled nothing further is executed, not even fail hooks.
 // As each context gets executed, its state should be updated in job run
 // instance store
 func (p *Planner) executeRun(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun) error {
-	// for each exec instance in job
-	// - check if job run instances(tasks/hooks) are already in finished state
-	// - if not find which one is not and create/execute them
-	// for now we will only support executing each task of a job and ignore
-	// all attached hooks
+	succeeded, err := p.executeHooks(ctx, namespace, jobRun, models.HookTypePre)
+	if err != nil || p.isRunCancelled(jobRun.ID) {
+		return err
+	}
+	if succeeded {
+		taskState, err := p.executeTask(ctx, namespace, jobRun)
+		if err != nil {
+			return err
+		}
+		switch taskState {
+		case models.RunStateSuccess:
+			if p.isRunCancelled(jobRun.ID) {
+				return nil
+			}
+			if succeeded, err = p.executeHooks(ctx, namespace, jobRun, models.HookTypePost); err != nil {
+				return err
+			}
+		case models.RunStateFailed:
+			succeeded = false
+		default:
+			// task is moved back for execution or cancelled, hooks
+			// will be handled when it is picked up again if needed
+			return nil
+		}
+	}
+	if !succeeded && !p.isRunCancelled(jobRun.ID) {
+		_, err = p.executeHooks(ctx, namespace, jobRun, models.HookTypeFail)
+	}
+	return err
+}
+
+// executeTask executes the task of job till it succeeds or retries are
+// exhausted and returns the final state of task
+func (p *Planner) executeTask(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun) (models.JobRunState, error) {
+	// - check if job run task is already in finished state
+	// - if not find the attempt which needs to be executed
 
 	instanceRepo := p.instanceRepoFac.New()
+	retry := jobRun.Spec.Behavior.Retry
 
 	// first check if this task is already in terminating state
+	attempt := 1
 	for _, instance := range jobRun.Instances {
 		if instance.Type == models.InstanceTypeTask &&
 			instance.Name == jobRun.Spec.Task.Unit.Info().Name {
-			if instance.Status == models.RunStateSuccess || instance.Status == models.RunStateFailed {
+			if instance.Status == models.RunStateSuccess ||
+				(instance.Status == models.RunStateFailed && instance.Attempt > retry.Count) {
 				// already finished
-				return nil
+				return instance.Status, nil
+			}
+			if instance.Status == models.RunStateFailed {
+				// retries are left but next attempt was never registered
+				attempt = instance.Attempt + 1
+				break
 			}
 
 			if instance.Status == models.RunStateRunning &&
@@ -360,81 +1034,365 @@ func (p *Planner) executeRun(ctx context.Context, namespace models.NamespaceSpec
 
 				// cancel task and move back state to accepted
 				if err := instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateAccepted); err != nil {
-					return err
+					return "", err
 				}
 
-				return p.executor.Stop(ctx, models.ExecutorStopRequest{
+				return models.RunStateAccepted, p.executor.Stop(ctx, models.ExecutorStopRequest{
 					ID:     instance.ID.String(),
 					Signal: "SIGKILL",
 				})
 			}
+			// instance registered but not finished, execute the same attempt again
+			if instance.Attempt > 0 {
+				attempt = instance.Attempt
+			}
+		}
+	}
+
+	instance, err := p.registerInstance(ctx, namespace, jobRun, attempt)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if p.isRunCancelled(jobRun.ID) {
+			return models.RunStateCancelled, instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateFailed)
+		}
+		finishCode, err := p.executeInstance(ctx, namespace, jobRun, instance)
+		if err != nil {
+			return "", err
+		}
+		if finishCode == 0 {
+			// mark instance success
+			if err := instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateSuccess); err != nil {
+				return "", err
+			}
+			p.l.Info("finished executing job spec", "job name", jobRun.Spec.Name, "attempt", instance.Attempt)
+			return models.RunStateSuccess, nil
+		}
+		p.l.Warn("job finished with non zero code", "code", finishCode, "job name", jobRun.Spec.Name, "attempt", instance.Attempt)
+
+		if p.isRunCancelled(jobRun.ID) {
+			// stopped by cancellation, no retries
+			return models.RunStateCancelled, instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateFailed)
+		}
+		if instance.Attempt > retry.Count {
+			// no retries left, mark instance failed
+			return models.RunStateFailed, instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateFailed)
+		}
+
+		// next attempt is registered before marking current one failed, this
+		// way job run will not be reconciled as failed while waiting for retry
+		nextInstance, err := p.registerInstance(ctx, namespace, jobRun, instance.Attempt+1)
+		if err != nil {
+			return "", err
+		}
+		if err := instanceRepo.UpdateStatus(ctx, instance.ID, models.RunStateFailed); err != nil {
+			return "", err
+		}
+
+		delay := retryDelay(retry, instance.Attempt)
+		p.l.Info("retrying job", "job name", jobRun.Spec.Name, "attempt", nextInstance.Attempt, "delay", delay)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(delay):
 		}
+		instance = nextInstance
 	}
+}
+
+// executeHooks runs hooks of provided type in the order of their
+// dependencies, a hook is skipped if any hook it depends on has not
+// succeeded. Returns false if any of the hooks didn't succeed
+func (p *Planner) executeHooks(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, hookType models.HookType) (bool, error) {
+	hooks := orderHooks(jobRun.Spec, hookType)
+	if len(hooks) == 0 {
+		return true, nil
+	}
+
+	// fetch instances registered so far, hooks share execution time with them
+	jobRun, _, err := p.jobRunRepoFac.New().GetByID(ctx, jobRun.ID)
+	if err != nil {
+		return false, err
+	}
+
+	succeeded := true
+	failedHooks := map[string]bool{}
+	for _, hook := range hooks {
+		if p.isRunCancelled(jobRun.ID) {
+			return false, nil
+		}
+		hookName := hook.Unit.Info().Name
+		for _, dependsOn := range hook.Unit.Info().DependsOn {
+			if failedHooks[dependsOn] {
+				failedHooks[hookName] = true
+				break
+			}
+		}
+		if failedHooks[hookName] {
+			p.l.Warn("skipping hook as its dependency failed", "job name", jobRun.Spec.Name, "hook name", hookName)
+			if err := p.skipHook(ctx, namespace, jobRun, hookName); err != nil {
+				return false, err
+			}
+			succeeded = false
+			continue
+		}
+
+		ok, err := p.executeHook(ctx, namespace, jobRun, hookName)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			failedHooks[hookName] = true
+			succeeded = false
+		}
+	}
+	return succeeded, nil
+}
+
+// executeHook registers an instance of hook and executes it if it has not
+// finished already, returns true if hook succeeded
+func (p *Planner) executeHook(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, hookName string) (bool, error) {
+	instance, err := p.runService.Register(ctx, namespace, jobRun, models.InstanceTypeHook, hookName)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to register hook %s of job %s", hookName, jobRun.Spec.Name)
+	}
+	switch instance.Status {
+	case models.RunStateSuccess:
+		return true, nil
+	case models.RunStateFailed:
+		return false, nil
+	}
+
+	finishCode, err := p.executeInstance(ctx, namespace, jobRun, instance)
+	if err != nil {
+		return false, err
+	}
+	finalState := models.RunStateSuccess
+	if finishCode != 0 {
+		p.l.Warn("hook finished with non zero code", "code", finishCode, "job name", jobRun.Spec.Name, "hook name", hookName)
+		finalState = models.RunStateFailed
+	}
+	if err := p.instanceRepoFac.New().UpdateStatus(ctx, instance.ID, finalState); err != nil {
+		return false, err
+	}
+	return finishCode == 0, nil
+}
+
+// skipHook registers an instance of hook which is not executed as its
+// dependency failed and marks it failed, so the run state can be inferred
+func (p *Planner) skipHook(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, hookName string) error {
+	instance, err := p.runService.Register(ctx, namespace, jobRun, models.InstanceTypeHook, hookName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to register hook %s of job %s", hookName, jobRun.Spec.Name)
+	}
+	switch instance.Status {
+	case models.RunStateSuccess, models.RunStateFailed:
+		return nil
+	}
+	return p.instanceRepoFac.New().UpdateStatus(ctx, instance.ID, models.RunStateFailed)
+}
 
-	// create an instance
+// orderHooks returns hooks of provided type ordered in a way that each hook
+// comes after the hooks it depends on. Dependencies on hooks of other types
+// are already satisfied by the order of execution so they are ignored
+func orderHooks(jobSpec models.JobSpec, hookType models.HookType) []models.JobSpecHook {
+	hooksByName := map[string]models.JobSpecHook{}
+	var hookNames []string
+	for _, hook := range jobSpec.Hooks {
+		if hook.Unit.Info().HookType != hookType {
+			continue
+		}
+		hooksByName[hook.Unit.Info().Name] = hook
+		hookNames = append(hookNames, hook.Unit.Info().Name)
+	}
+
+	var ordered []models.JobSpecHook
+	visited := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		hook, ok := hooksByName[name]
+		if !ok || visited[name] {
+			return
+		}
+		// marked before visiting dependencies to break cycles
+		visited[name] = true
+		for _, dependsOn := range hook.Unit.Info().DependsOn {
+			visit(dependsOn)
+		}
+		ordered = append(ordered, hook)
+	}
+	for _, name := range hookNames {
+		visit(name)
+	}
+	return ordered
+}
+
+// registerInstance creates a new attempt of job task in the run
+func (p *Planner) registerInstance(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, attempt int) (models.InstanceSpec, error) {
 	instanceID, err := p.uuidProvider.NewUUID()
 	if err != nil {
-		return err
+		return models.InstanceSpec{}, err
 	}
-	newInstance := models.InstanceSpec{
-		ID:     instanceID,
-		Name:   jobRun.Spec.Task.Unit.Info().Name,
-		Type:   models.InstanceTypeTask,
-		Status: models.RunStateAccepted,
+	newInstance, err := p.runService.PrepInstance(ctx, jobRun, models.InstanceTypeTask, jobRun.Spec.Task.Unit.Info().Name)
+	if err != nil {
+		return models.InstanceSpec{}, errors.Wrapf(err, "failed to prepare task of job %s", jobRun.Spec.Name)
 	}
+	newInstance.ID = instanceID
+	newInstance.Status = models.RunStateAccepted
+	newInstance.Attempt = attempt
 	if err := p.jobRunRepoFac.New().AddInstance(ctx, namespace, jobRun, newInstance); err != nil {
-		return err
+		return models.InstanceSpec{}, err
 	}
+	return newInstance, nil
+}
 
+// executeInstance sends the instance to executor and blocks until it
+// finishes, returning its exit code
+func (p *Planner) executeInstance(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun, instance models.InstanceSpec) (int, error) {
 	// send it to executor for execution
-	p.l.Info("starting executing job", "job name", jobRun.Spec.Name)
-	_, err = p.executor.Start(ctx, models.ExecutorStartRequest{
-		ID:        newInstance.ID.String(),
+	p.l.Info("starting executing job", "job name", jobRun.Spec.Name, "attempt", instance.Attempt)
+	_, err := p.executor.Start(ctx, models.ExecutorStartRequest{
+		ID:        instance.ID.String(),
 		Job:       jobRun.Spec,
 		Namespace: namespace,
+		JobRun:    jobRun,
+		Instance:  instance,
 	})
 	if err != nil {
-		return err
+		return 0, err
 	}
-	if err := p.instanceRepoFac.New().UpdateStatus(ctx, instanceID, models.RunStateRunning); err != nil {
-		return err
+	if err := p.instanceRepoFac.New().UpdateStatus(ctx, instance.ID, models.RunStateRunning); err != nil {
+		return 0, err
+	}
+
+	var logsCaptured chan struct{}
+	if p.instanceLogRepo != nil {
+		logStream, err := p.executor.Logs(ctx, instance.ID.String())
+		if err != nil {
+			return 0, err
+		}
+		logsCaptured = p.captureLogs(ctx, instance.ID, logStream)
 	}
 
 	// block until the given task finishes
-	finishChan, err := p.executor.WaitForFinish(ctx, newInstance.ID.String())
+	finishChan, err := p.executor.WaitForFinish(ctx, instance.ID.String())
 	if err != nil {
-		return err
+		return 0, err
 	}
 	finishCode := <-finishChan
-	if finishCode != 0 {
-		p.l.Warn("job finished with non zero code", "code", finishCode, "job name", jobRun.Spec.Name)
+	if logsCaptured != nil {
+		// logs should be complete by the time instance is marked finished
+		<-logsCaptured
+	}
+	return finishCode, nil
+}
 
-		// mark instance failed
-		if err := instanceRepo.UpdateStatus(ctx, newInstance.ID, models.RunStateFailed); err != nil {
-			return err
+// captureLogs persists output of an instance while it is executing, logs are
+// flushed periodically so that they can be followed. Returned channel is
+// closed once the stream has ended and all of the output is persisted
+func (p *Planner) captureLogs(ctx context.Context, instanceID uuid.UUID, logStream io.ReadCloser) chan struct{} {
+	var (
+		logs bytes.Buffer
+		mu   sync.Mutex
+	)
+	streamEnded := make(chan struct{})
+	go func() {
+		defer close(streamEnded)
+		defer logStream.Close()
+		chunk := make([]byte, 32*1024)
+		for {
+			n, err := logStream.Read(chunk)
+			if n > 0 {
+				mu.Lock()
+				logs.Write(chunk[:n])
+				mu.Unlock()
+			}
+			if err != nil {
+				if err != io.EOF {
+					p.l.Warn("failed to read instance logs", "instance id", instanceID, "error", err)
+				}
+				return
+			}
 		}
-	}
+	}()
 
-	// mark instance success
-	if err := instanceRepo.UpdateStatus(ctx, newInstance.ID, models.RunStateSuccess); err != nil {
-		return err
+	captured := make(chan struct{})
+	go func() {
+		defer close(captured)
+		flushedSize := -1
+		flush := func() {
+			mu.Lock()
+			output := append([]byte(nil), logs.Bytes()...)
+			mu.Unlock()
+			if len(output) == flushedSize {
+				return
+			}
+			if err := p.instanceLogRepo.Save(ctx, instanceID, output); err != nil {
+				p.l.Warn("failed to persist instance logs", "instance id", instanceID, "error", err)
+				return
+			}
+			flushedSize = len(output)
+		}
+
+		ticker := time.NewTicker(LogFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-streamEnded:
+				flush()
+				return
+			case <-ticker.C:
+				flush()
+			}
+		}
+	}()
+	return captured
+}
+
+// retryDelay is the time to wait before making next attempt after provided
+// attempt failed
+func retryDelay(retry models.JobSpecBehaviorRetry, failedAttempt int) time.Duration {
+	if !retry.ExponentialBackoff || failedAttempt < 1 {
+		return retry.Delay
 	}
-	p.l.Info("finished executing job spec", "job name", jobRun.Spec.Name)
-	return nil
+	return retry.Delay * time.Duration(1<<uint(failedAttempt-1))
 }
 
+// NewPlanner creates a planner for the cluster, if project repository factory
+// is nil planner will not materialize scheduled runs and only execute manually
+// triggered runs. Dependency resolver is only needed for scheduled runs.
+// Output of instances is persisted only if log repository is provided
 func NewPlanner(l log.Logger, sv ClusterManager, jobRunRepoFac RunRepoFactory,
-	instanceRepoFactory InstanceRepoFactory, uuidProvider utils.UUIDProvider,
-	executor models.ExecutorUnit, now func() time.Time) *Planner {
+	instanceRepoFactory InstanceRepoFactory, projectRepoFac ProjectRepoFactory,
+	namespaceRepoFac NamespaceRepoFactory, jobSpecRepoFac JobSpecRepoFactory,
+	dependencyResolver job.DependencyResolver, runService models.RunService,
+	uuidProvider utils.UUIDProvider, executor models.ExecutorUnit, instanceLogRepo store.InstanceLogRepository,
+	now func() time.Time) *Planner {
+	triggers := []models.JobRunTrigger{models.TriggerManual}
+	if projectRepoFac != nil {
+		triggers = append(triggers, models.TriggerSchedule)
+	}
 	return &Planner{
-		l:               l,
-		clusterManager:  sv,
-		jobRunRepoFac:   jobRunRepoFac,
-		instanceRepoFac: instanceRepoFactory,
-		executor:        executor,
-		uuidProvider:    uuidProvider,
-		now:             now,
-		wg:              new(sync.WaitGroup),
-		errChan:         make(chan error),
+		l:                  l,
+		clusterManager:     sv,
+		jobRunRepoFac:      jobRunRepoFac,
+		instanceRepoFac:    instanceRepoFactory,
+		projectRepoFac:     projectRepoFac,
+		namespaceRepoFac:   namespaceRepoFac,
+		jobSpecRepoFac:     jobSpecRepoFac,
+		dependencyResolver: dependencyResolver,
+		runService:         runService,
+		executor:           executor,
+		instanceLogRepo:    instanceLogRepo,
+		uuidProvider:       uuidProvider,
+		triggers:           triggers,
+		lastMaterialized:   map[uuid.UUID]time.Time{},
+		runNamespaces:      map[uuid.UUID]models.NamespaceSpec{},
+		cancelledRuns:      map[uuid.UUID]bool{},
+		mu:                 new(sync.Mutex),
+		now:                now,
+		wg:                 new(sync.WaitGroup),
+		errChan:            make(chan error),
 	}
 }
@@ -0,0 +1,1008 @@
+package prime
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/serf/serf"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster"
+	"github.com/odpf/optimus/core/gossip"
+	"github.com/odpf/optimus/core/set"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	mock2 "github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPlanner(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "ns",
+		ProjectSpec: projectSpec,
+	}
+	now := time.Date(2021, 11, 3, 12, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	setupRepos := func(jobSpecs []models.JobSpec) (*mock.ProjectRepoFactory, *mock.NamespaceRepoFactory, *mock.JobSpecRepoFactory) {
+		projectRepo := new(mock.ProjectRepository)
+		projectRepo.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec}, nil)
+		projectRepoFac := new(mock.ProjectRepoFactory)
+		projectRepoFac.On("New").Return(projectRepo)
+
+		namespaceRepo := new(mock.NamespaceRepository)
+		namespaceRepo.On("GetAll", ctx).Return([]models.NamespaceSpec{namespaceSpec}, nil)
+		namespaceRepoFac := new(mock.NamespaceRepoFactory)
+		namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll", ctx).Return(jobSpecs, nil)
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		return projectRepoFac, namespaceRepoFac, jobSpecRepoFac
+	}
+
+	t.Run("materializeRuns", func(t *testing.T) {
+		t.Run("should create runs for all elapsed ticks if catch up is enabled", func(t *testing.T) {
+			jobSpec := models.JobSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "job-1",
+				Schedule: models.JobSpecSchedule{
+					StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "0 2 * * *",
+				},
+				Behavior: models.JobSpecBehavior{
+					CatchUp: true,
+				},
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos([]models.JobSpec{jobSpec})
+
+			firstTick := time.Date(2021, 11, 1, 2, 0, 0, 0, time.UTC)
+			secondTick := time.Date(2021, 11, 2, 2, 0, 0, 0, time.UTC)
+			thirdTick := time.Date(2021, 11, 3, 2, 0, 0, 0, time.UTC)
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, firstTick).Return(models.JobRun{ScheduledAt: firstTick}, namespaceSpec, nil)
+			for _, tick := range []time.Time{secondTick, thirdTick} {
+				runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, tick).Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound).Once()
+				runRepo.On("Save", ctx, namespaceSpec, models.JobRun{
+					Spec:        jobSpec,
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStatePending,
+					ScheduledAt: tick,
+				}).Return(nil).Once()
+			}
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.materializeRuns(ctx))
+			assert.Equal(t, thirdTick, planner.lastMaterialized[jobSpec.ID])
+
+			// nothing new to materialize on subsequent passes
+			assert.Nil(t, planner.materializeRuns(ctx))
+		})
+		t.Run("should create run only for the latest tick if catch up is disabled", func(t *testing.T) {
+			jobSpec := models.JobSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "job-1",
+				Schedule: models.JobSpecSchedule{
+					StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "@daily",
+				},
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos([]models.JobSpec{jobSpec})
+
+			latestTick := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, latestTick).Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound)
+			runRepo.On("Save", ctx, namespaceSpec, models.JobRun{
+				Spec:        jobSpec,
+				Trigger:     models.TriggerSchedule,
+				Status:      models.RunStatePending,
+				ScheduledAt: latestTick,
+			}).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.materializeRuns(ctx))
+		})
+		t.Run("should find the latest tick of long running jobs if catch up is disabled", func(t *testing.T) {
+			jobSpecs := []models.JobSpec{
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-every-minute",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Interval:  "* * * * *",
+					},
+				},
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-twice-a-month",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+						Interval:  "0 0 1,15 * *",
+					},
+				},
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos(jobSpecs)
+
+			latestTicks := []time.Time{now, time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)}
+			runRepo := new(mock.JobRunRepository)
+			for i, jobSpec := range jobSpecs {
+				runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, latestTicks[i]).Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound)
+				runRepo.On("Save", ctx, namespaceSpec, models.JobRun{
+					Spec:        jobSpec,
+					Trigger:     models.TriggerSchedule,
+					Status:      models.RunStatePending,
+					ScheduledAt: latestTicks[i],
+				}).Return(nil)
+			}
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.materializeRuns(ctx))
+		})
+		t.Run("should not create runs after end date or before start date", func(t *testing.T) {
+			endDate := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+			jobSpecs := []models.JobSpec{
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-ended",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:   &endDate,
+						Interval:  "@daily",
+					},
+					Behavior: models.JobSpecBehavior{
+						CatchUp: true,
+					},
+				},
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-in-future",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+						Interval:  "@daily",
+					},
+				},
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-without-schedule",
+				},
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos(jobSpecs)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpecs[0].ID, endDate).Return(models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound)
+			runRepo.On("Save", ctx, namespaceSpec, models.JobRun{
+				Spec:        jobSpecs[0],
+				Trigger:     models.TriggerSchedule,
+				Status:      models.RunStatePending,
+				ScheduledAt: endDate,
+			}).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.materializeRuns(ctx))
+		})
+		t.Run("should not create runs of paused jobs", func(t *testing.T) {
+			jobSpec := models.JobSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "job-paused",
+				Schedule: models.JobSpecSchedule{
+					StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "@daily",
+				},
+				Behavior: models.JobSpecBehavior{
+					CatchUp: true,
+				},
+				Paused: true,
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos([]models.JobSpec{jobSpec})
+
+			runRepo := new(mock.JobRunRepository)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.materializeRuns(ctx))
+			_, ok := planner.lastMaterialized[jobSpec.ID]
+			assert.False(t, ok)
+		})
+		t.Run("should continue with other jobs if schedule of one is invalid", func(t *testing.T) {
+			jobSpecs := []models.JobSpec{
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-invalid",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+						Interval:  "invalid",
+					},
+				},
+				{
+					ID:   uuid.Must(uuid.NewRandom()),
+					Name: "job-valid",
+					Schedule: models.JobSpecSchedule{
+						StartDate: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC),
+						Interval:  "@daily",
+					},
+				},
+			}
+			projectRepoFac, namespaceRepoFac, jobSpecRepoFac := setupRepos(jobSpecs)
+
+			latestTick := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpecs[1].ID, latestTick).Return(models.JobRun{}, models.NamespaceSpec{}, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, nil, projectRepoFac, namespaceRepoFac, jobSpecRepoFac, nil, nil, nil, nil, nil, nowFn)
+			err := planner.materializeRuns(ctx)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "failed to parse schedule of job job-invalid")
+		})
+	})
+	t.Run("getJobAllocations", func(t *testing.T) {
+		t.Run("should allocate runs by priority within peer capacity and namespace concurrency", func(t *testing.T) {
+			cappedNamespace := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "capped",
+				Config:      map[string]string{models.NamespaceConcurrencyKey: "1"},
+				ProjectSpec: projectSpec,
+			}
+			newRun := func(priority int, scheduledAt time.Time) models.JobRun {
+				return models.JobRun{
+					ID: uuid.Must(uuid.NewRandom()),
+					Spec: models.JobSpec{
+						Name: "job",
+						Task: models.JobSpecTask{Priority: priority},
+					},
+					Trigger:     models.TriggerManual,
+					Status:      models.RunStatePending,
+					ScheduledAt: scheduledAt,
+				}
+			}
+			activeRun := newRun(10, now.Add(-time.Hour*2))
+			highRun := newRun(100, now)
+			lowOldRun := newRun(10, now.Add(-time.Hour))
+			lowRun := newRun(10, now)
+			cappedRun := newRun(1000, now)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByTrigger", ctx, models.TriggerManual, []models.JobRunState{models.RunStatePending}).
+				Return([]models.JobRun{lowRun, cappedRun, highRun, lowOldRun}, nil)
+			runRepo.On("GetByID", ctx, activeRun.ID).Return(activeRun, cappedNamespace, nil)
+			runRepo.On("GetByID", ctx, cappedRun.ID).Return(cappedRun, cappedNamespace, nil)
+			runRepo.On("GetByID", ctx, highRun.ID).Return(highRun, namespaceSpec, nil)
+			runRepo.On("GetByID", ctx, lowOldRun.ID).Return(lowOldRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			node2Alloc := set.NewHashSet()
+			node2Alloc.Add(gossip.StateJob{
+				UUID:   activeRun.ID.String(),
+				Status: models.RunStateRunning.String(),
+			})
+			clusterManager := new(mock.ClusterManager)
+			clusterManager.On("GetClusterMembers").Return([]serf.Member{
+				{Name: "node-1", Status: serf.StatusAlive, Tags: map[string]string{gossip.TagCapacity: "1"}},
+				{Name: "node-2", Status: serf.StatusAlive, Tags: map[string]string{gossip.TagCapacity: "2"}},
+				{Name: "node-3", Status: serf.StatusFailed, Tags: map[string]string{gossip.TagCapacity: "5"}},
+			})
+			clusterManager.On("GetState").Return(gossip.State{
+				Allocation: map[string]set.Set{
+					"node-2": node2Alloc,
+				},
+			})
+
+			planner := NewPlanner(log.NewNoop(), clusterManager, runRepoFac, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			allocations, err := planner.getJobAllocations(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string][]uuid.UUID{
+				"node-1": {highRun.ID},
+				"node-2": {lowOldRun.ID},
+			}, allocations)
+		})
+	})
+	t.Run("peerCapacity", func(t *testing.T) {
+		t.Run("should use advertised capacity of peer", func(t *testing.T) {
+			assert.Equal(t, 4, peerCapacity(serf.Member{Tags: map[string]string{gossip.TagCapacity: "4"}}))
+		})
+		t.Run("should fallback to default pool size if capacity is invalid", func(t *testing.T) {
+			assert.Equal(t, PeerPoolSize, peerCapacity(serf.Member{Tags: map[string]string{}}))
+			assert.Equal(t, PeerPoolSize, peerCapacity(serf.Member{Tags: map[string]string{gossip.TagCapacity: "many"}}))
+		})
+	})
+	t.Run("executeRun", func(t *testing.T) {
+		execUnit := new(mock.BasePlugin)
+		execUnit.On("PluginInfo").Return(&models.PluginInfoResponse{
+			Name: "bq2bq",
+		}, nil)
+		newJobRun := func(retry models.JobSpecBehaviorRetry, instances []models.InstanceSpec) models.JobRun {
+			return models.JobRun{
+				ID: uuid.Must(uuid.NewRandom()),
+				Spec: models.JobSpec{
+					Name: "job-1",
+					Task: models.JobSpecTask{
+						Unit: &models.Plugin{Base: execUnit},
+					},
+					Behavior: models.JobSpecBehavior{
+						Retry: retry,
+					},
+				},
+				Instances: instances,
+			}
+		}
+		finishedWith := func(code int) chan int {
+			finishChan := make(chan int, 1)
+			finishChan <- code
+			return finishChan
+		}
+		taskData := []models.InstanceSpecData{
+			{Name: "DSTART", Value: "2021-01-01T00:00:00Z", Type: models.InstanceDataTypeEnv},
+		}
+
+		t.Run("should retry failed instance till it succeeds", func(t *testing.T) {
+			jobRun := newJobRun(models.JobSpecBehaviorRetry{Count: 2}, nil)
+			firstAttempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateAccepted,
+				Attempt: 1,
+				Data:    taskData,
+			}
+			secondAttempt := firstAttempt
+			secondAttempt.ID = uuid.Must(uuid.NewRandom())
+			secondAttempt.Attempt = 2
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(firstAttempt.ID, nil).Once()
+			uuidProvider.On("NewUUID").Return(secondAttempt.ID, nil).Once()
+			defer uuidProvider.AssertExpectations(t)
+
+			runService := new(mock.RunService)
+			runService.On("PrepInstance", ctx, jobRun, models.InstanceTypeTask, "bq2bq").Return(models.InstanceSpec{
+				Name: "bq2bq",
+				Type: models.InstanceTypeTask,
+				Data: taskData,
+			}, nil)
+			defer runService.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("AddInstance", ctx, namespaceSpec, jobRun, firstAttempt).Return(nil)
+			runRepo.On("AddInstance", ctx, namespaceSpec, jobRun, secondAttempt).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			instanceRepo := new(mock.InstanceRepository)
+			instanceRepo.On("UpdateStatus", ctx, firstAttempt.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, firstAttempt.ID, models.RunStateFailed).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, secondAttempt.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, secondAttempt.ID, models.RunStateSuccess).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			executor := new(mock.Executor)
+			for _, attempt := range []models.InstanceSpec{firstAttempt, secondAttempt} {
+				executor.On("Start", ctx, models.ExecutorStartRequest{
+					ID:        attempt.ID.String(),
+					Job:       jobRun.Spec,
+					Namespace: namespaceSpec,
+					JobRun:    jobRun,
+					Instance:  attempt,
+				}).Return(&models.ExecutorStartResponse{}, nil)
+			}
+			executor.On("WaitForFinish", ctx, firstAttempt.ID.String()).Return(finishedWith(1), nil)
+			executor.On("WaitForFinish", ctx, secondAttempt.ID.String()).Return(finishedWith(0), nil)
+			defer executor.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, uuidProvider, executor, nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+		t.Run("should mark instance failed once retries are exhausted", func(t *testing.T) {
+			previousAttempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateFailed,
+				Attempt: 1,
+			}
+			jobRun := newJobRun(models.JobSpecBehaviorRetry{Count: 1}, []models.InstanceSpec{previousAttempt})
+			lastAttempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateAccepted,
+				Attempt: 2,
+				Data:    taskData,
+			}
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(lastAttempt.ID, nil).Once()
+			defer uuidProvider.AssertExpectations(t)
+
+			runService := new(mock.RunService)
+			runService.On("PrepInstance", ctx, jobRun, models.InstanceTypeTask, "bq2bq").Return(models.InstanceSpec{
+				Name: "bq2bq",
+				Type: models.InstanceTypeTask,
+				Data: taskData,
+			}, nil)
+			defer runService.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("AddInstance", ctx, namespaceSpec, jobRun, lastAttempt).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			instanceRepo := new(mock.InstanceRepository)
+			instanceRepo.On("UpdateStatus", ctx, lastAttempt.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, lastAttempt.ID, models.RunStateFailed).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			executor := new(mock.Executor)
+			executor.On("Start", ctx, models.ExecutorStartRequest{
+				ID:        lastAttempt.ID.String(),
+				Job:       jobRun.Spec,
+				Namespace: namespaceSpec,
+				JobRun:    jobRun,
+				Instance:  lastAttempt,
+			}).Return(&models.ExecutorStartResponse{}, nil)
+			executor.On("WaitForFinish", ctx, lastAttempt.ID.String()).Return(finishedWith(2), nil)
+			defer executor.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, uuidProvider, executor, nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+		t.Run("should skip run if its task already failed without retries left", func(t *testing.T) {
+			jobRun := newJobRun(models.JobSpecBehaviorRetry{Count: 1}, []models.InstanceSpec{
+				{
+					Name:    "bq2bq",
+					Type:    models.InstanceTypeTask,
+					Status:  models.RunStateFailed,
+					Attempt: 2,
+				},
+			})
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(new(mock.InstanceRepository))
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, instanceRepoFac, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+		t.Run("should not retry instance if run is cancelled", func(t *testing.T) {
+			jobRun := newJobRun(models.JobSpecBehaviorRetry{Count: 2}, nil)
+			attempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateAccepted,
+				Attempt: 1,
+				Data:    taskData,
+			}
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(attempt.ID, nil).Once()
+			defer uuidProvider.AssertExpectations(t)
+
+			runService := new(mock.RunService)
+			runService.On("PrepInstance", ctx, jobRun, models.InstanceTypeTask, "bq2bq").Return(models.InstanceSpec{
+				Name: "bq2bq",
+				Type: models.InstanceTypeTask,
+				Data: taskData,
+			}, nil)
+			defer runService.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("AddInstance", ctx, namespaceSpec, jobRun, attempt).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			instanceRepo := new(mock.InstanceRepository)
+			instanceRepo.On("UpdateStatus", ctx, attempt.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, attempt.ID, models.RunStateFailed).Return(nil)
+			defer instanceRepo.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, uuidProvider, nil, nil, nowFn)
+			executor := new(mock.Executor)
+			executor.On("Start", ctx, models.ExecutorStartRequest{
+				ID:        attempt.ID.String(),
+				Job:       jobRun.Spec,
+				Namespace: namespaceSpec,
+				JobRun:    jobRun,
+				Instance:  attempt,
+			}).Return(&models.ExecutorStartResponse{}, nil)
+			// run gets cancelled while instance is executing
+			executor.On("WaitForFinish", ctx, attempt.ID.String()).Return(finishedWith(143), nil).Run(func(args mock2.Arguments) {
+				planner.markRunCancelled(jobRun.ID)
+			})
+			defer executor.AssertExpectations(t)
+			planner.executor = executor
+
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+	})
+	t.Run("executeRun with hooks", func(t *testing.T) {
+		newPlugin := func(name string, hookType models.HookType, dependsOn ...string) *models.Plugin {
+			unit := new(mock.BasePlugin)
+			unit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name:      name,
+				HookType:  hookType,
+				DependsOn: dependsOn,
+			}, nil)
+			return &models.Plugin{Base: unit}
+		}
+		jobRun := models.JobRun{
+			ID: uuid.Must(uuid.NewRandom()),
+			Spec: models.JobSpec{
+				Name: "job-1",
+				Task: models.JobSpecTask{
+					Unit: newPlugin("bq2bq", ""),
+				},
+				Hooks: []models.JobSpecHook{
+					{Unit: newPlugin("notify", models.HookTypeFail)},
+					{Unit: newPlugin("predator", models.HookTypePost)},
+					{Unit: newPlugin("transporter", models.HookTypePre)},
+				},
+			},
+		}
+		finishedWith := func(code int) chan int {
+			finishChan := make(chan int, 1)
+			finishChan <- code
+			return finishChan
+		}
+		setupHook := func(runService *mock.RunService, instanceRepo *mock.InstanceRepository, executor *mock.Executor,
+			name string, finishCode int) {
+			instance := models.InstanceSpec{
+				ID:     uuid.Must(uuid.NewRandom()),
+				Name:   name,
+				Type:   models.InstanceTypeHook,
+				Status: models.RunStateRunning,
+			}
+			finalState := models.RunStateSuccess
+			if finishCode != 0 {
+				finalState = models.RunStateFailed
+			}
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeHook, name).Return(instance, nil)
+			instanceRepo.On("UpdateStatus", ctx, instance.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, instance.ID, finalState).Return(nil)
+			executor.On("Start", ctx, models.ExecutorStartRequest{
+				ID:        instance.ID.String(),
+				Job:       jobRun.Spec,
+				Namespace: namespaceSpec,
+				JobRun:    jobRun,
+				Instance:  instance,
+			}).Return(&models.ExecutorStartResponse{}, nil)
+			executor.On("WaitForFinish", ctx, instance.ID.String()).Return(finishedWith(finishCode), nil)
+		}
+
+		t.Run("should execute post hooks after task succeeds", func(t *testing.T) {
+			taskInstance := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq2bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateAccepted,
+				Attempt: 1,
+			}
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(taskInstance.ID, nil)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			runRepo.On("AddInstance", ctx, namespaceSpec, jobRun, taskInstance).Return(nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			runService := new(mock.RunService)
+			runService.On("PrepInstance", ctx, jobRun, models.InstanceTypeTask, "bq2bq").Return(models.InstanceSpec{
+				Name: "bq2bq",
+				Type: models.InstanceTypeTask,
+			}, nil)
+			instanceRepo := new(mock.InstanceRepository)
+			executor := new(mock.Executor)
+			setupHook(runService, instanceRepo, executor, "transporter", 0)
+			setupHook(runService, instanceRepo, executor, "predator", 0)
+			instanceRepo.On("UpdateStatus", ctx, taskInstance.ID, models.RunStateRunning).Return(nil)
+			instanceRepo.On("UpdateStatus", ctx, taskInstance.ID, models.RunStateSuccess).Return(nil)
+			executor.On("Start", ctx, models.ExecutorStartRequest{
+				ID:        taskInstance.ID.String(),
+				Job:       jobRun.Spec,
+				Namespace: namespaceSpec,
+				JobRun:    jobRun,
+				Instance:  taskInstance,
+			}).Return(&models.ExecutorStartResponse{}, nil)
+			executor.On("WaitForFinish", ctx, taskInstance.ID.String()).Return(finishedWith(0), nil)
+			defer runService.AssertExpectations(t)
+			defer instanceRepo.AssertExpectations(t)
+			defer executor.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, uuidProvider, executor, nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+		t.Run("should skip task and execute fail hooks if a pre hook fails", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			runService := new(mock.RunService)
+			instanceRepo := new(mock.InstanceRepository)
+			executor := new(mock.Executor)
+			setupHook(runService, instanceRepo, executor, "transporter", 1)
+			setupHook(runService, instanceRepo, executor, "notify", 0)
+			defer runService.AssertExpectations(t)
+			defer instanceRepo.AssertExpectations(t)
+			defer executor.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, nil, executor, nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+		t.Run("should mark fail hooks failed if their dependency fails", func(t *testing.T) {
+			jobRun := jobRun
+			jobRun.Spec.Hooks = []models.JobSpecHook{
+				{Unit: newPlugin("notify", models.HookTypeFail)},
+				{Unit: newPlugin("alert", models.HookTypeFail, "notify")},
+			}
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			runService := new(mock.RunService)
+			instanceRepo := new(mock.InstanceRepository)
+			executor := new(mock.Executor)
+			setupHook := func(name string, finishCode int) {
+				instance := models.InstanceSpec{
+					ID:     uuid.Must(uuid.NewRandom()),
+					Name:   name,
+					Type:   models.InstanceTypeHook,
+					Status: models.RunStateRunning,
+				}
+				runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeHook, name).Return(instance, nil)
+				instanceRepo.On("UpdateStatus", ctx, instance.ID, models.RunStateRunning).Return(nil)
+				instanceRepo.On("UpdateStatus", ctx, instance.ID, models.RunStateFailed).Return(nil)
+				executor.On("Start", ctx, models.ExecutorStartRequest{
+					ID:        instance.ID.String(),
+					Job:       jobRun.Spec,
+					Namespace: namespaceSpec,
+					JobRun:    jobRun,
+					Instance:  instance,
+				}).Return(&models.ExecutorStartResponse{}, nil)
+				executor.On("WaitForFinish", ctx, instance.ID.String()).Return(finishedWith(finishCode), nil)
+			}
+			setupHook("notify", 1)
+			skippedInstance := models.InstanceSpec{
+				ID:     uuid.Must(uuid.NewRandom()),
+				Name:   "alert",
+				Type:   models.InstanceTypeHook,
+				Status: models.RunStateRunning,
+			}
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeHook, "alert").Return(skippedInstance, nil)
+			instanceRepo.On("UpdateStatus", ctx, skippedInstance.ID, models.RunStateFailed).Return(nil)
+			defer runService.AssertExpectations(t)
+			defer instanceRepo.AssertExpectations(t)
+			defer executor.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(instanceRepo)
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, nil, executor, nil, nowFn)
+			ok, err := planner.executeHooks(ctx, namespaceSpec, jobRun, models.HookTypeFail)
+			assert.Nil(t, err)
+			assert.False(t, ok)
+		})
+		t.Run("should not execute hooks which have already finished", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByID", ctx, jobRun.ID).Return(jobRun, namespaceSpec, nil)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+
+			runService := new(mock.RunService)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeHook, "transporter").Return(models.InstanceSpec{
+				Name:   "transporter",
+				Type:   models.InstanceTypeHook,
+				Status: models.RunStateFailed,
+			}, nil)
+			runService.On("Register", ctx, namespaceSpec, jobRun, models.InstanceTypeHook, "notify").Return(models.InstanceSpec{
+				Name:   "notify",
+				Type:   models.InstanceTypeHook,
+				Status: models.RunStateSuccess,
+			}, nil)
+			defer runService.AssertExpectations(t)
+			instanceRepoFac := new(mock.InstanceSpecRepoFactory)
+			instanceRepoFac.On("New").Return(new(mock.InstanceRepository))
+
+			planner := NewPlanner(log.NewNoop(), nil, runRepoFac, instanceRepoFac, nil, nil, nil, nil, runService, nil, new(mock.Executor), nil, nowFn)
+			assert.Nil(t, planner.executeRun(ctx, namespaceSpec, jobRun))
+		})
+	})
+	t.Run("orderHooks", func(t *testing.T) {
+		newHook := func(name string, hookType models.HookType, dependsOn ...string) models.JobSpecHook {
+			unit := new(mock.BasePlugin)
+			unit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name:      name,
+				HookType:  hookType,
+				DependsOn: dependsOn,
+			}, nil)
+			return models.JobSpecHook{Unit: &models.Plugin{Base: unit}}
+		}
+		t.Run("should order hooks of a type after the hooks they depend on", func(t *testing.T) {
+			jobSpec := models.JobSpec{
+				Hooks: []models.JobSpecHook{
+					newHook("c", models.HookTypePost, "b"),
+					newHook("a", models.HookTypePre),
+					newHook("b", models.HookTypePost, "a", "d"),
+					newHook("d", models.HookTypePost),
+				},
+			}
+			var names []string
+			for _, hook := range orderHooks(jobSpec, models.HookTypePost) {
+				names = append(names, hook.Unit.Info().Name)
+			}
+			assert.Equal(t, []string{"d", "b", "c"}, names)
+		})
+		t.Run("should not loop forever on cyclic dependencies", func(t *testing.T) {
+			jobSpec := models.JobSpec{
+				Hooks: []models.JobSpecHook{
+					newHook("a", models.HookTypePre, "b"),
+					newHook("b", models.HookTypePre, "a"),
+				},
+			}
+			assert.Equal(t, 2, len(orderHooks(jobSpec, models.HookTypePre)))
+		})
+	})
+	t.Run("captureLogs", func(t *testing.T) {
+		t.Run("should persist complete output once stream ends", func(t *testing.T) {
+			instanceID := uuid.Must(uuid.NewRandom())
+			logRepo := new(mock.InstanceLogRepository)
+			logRepo.On("Save", ctx, instanceID, []byte("first\nsecond\n")).Return(nil).Once()
+			defer logRepo.AssertExpectations(t)
+
+			planner := NewPlanner(log.NewNoop(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, logRepo, nowFn)
+			captured := planner.captureLogs(ctx, instanceID, ioutil.NopCloser(strings.NewReader("first\nsecond\n")))
+			select {
+			case <-captured:
+			case <-time.After(time.Second * 5):
+				t.Fatal("logs were not captured")
+			}
+		})
+	})
+	t.Run("cancelRuns", func(t *testing.T) {
+		newCancellingRun := func(instances ...models.InstanceSpec) models.JobRun {
+			return models.JobRun{
+				ID:        uuid.Must(uuid.NewRandom()),
+				Spec:      models.JobSpec{Name: "job"},
+				Trigger:   models.TriggerManual,
+				Status:    models.RunStateCancelling,
+				Instances: instances,
+			}
+		}
+		commandOf := func(cmdType pb.CommandLog_Type) interface{} {
+			return mock2.MatchedBy(func(cmd *pb.CommandLog) bool {
+				return cmd.Type == cmdType
+			})
+		}
+		unallocatedRun := newCancellingRun()
+		requestedRun := newCancellingRun(models.InstanceSpec{Type: models.InstanceTypeTask, Status: models.RunStateRunning})
+		stoppingRun := newCancellingRun(models.InstanceSpec{Type: models.InstanceTypeTask, Status: models.RunStateRunning})
+		stoppedRun := newCancellingRun(models.InstanceSpec{Type: models.InstanceTypeTask, Status: models.RunStateFailed})
+
+		runRepo := new(mock.JobRunRepository)
+		runRepo.On("GetByTrigger", ctx, models.TriggerManual, []models.JobRunState{models.RunStateCancelling}).
+			Return([]models.JobRun{unallocatedRun, requestedRun, stoppingRun, stoppedRun}, nil)
+		runRepo.On("UpdateStatus", ctx, unallocatedRun.ID, models.RunStateCancelled).Return(nil)
+		runRepo.On("UpdateStatus", ctx, stoppedRun.ID, models.RunStateCancelled).Return(nil)
+		defer runRepo.AssertExpectations(t)
+		runRepoFac := new(mock.JobRunRepoFactory)
+		runRepoFac.On("New").Return(runRepo)
+
+		nodeAlloc := set.NewHashSet()
+		nodeAlloc.Add(gossip.StateJob{UUID: requestedRun.ID.String(), Status: models.RunStateRunning.String()})
+		nodeAlloc.Add(gossip.StateJob{UUID: stoppingRun.ID.String(), Status: models.RunStateCancelling.String()})
+		nodeAlloc.Add(gossip.StateJob{UUID: stoppedRun.ID.String(), Status: models.RunStateCancelling.String()})
+		clusterManager := new(mock.ClusterManager)
+		clusterManager.On("GetState").Return(gossip.State{
+			Allocation: map[string]set.Set{
+				"node-1": nodeAlloc,
+			},
+		})
+		clusterManager.On("ApplyCommand", commandOf(pb.CommandLog_COMMAND_TYPE_CANCEL_JOB)).Return(nil).Once()
+		clusterManager.On("ApplyCommand", commandOf(pb.CommandLog_COMMAND_TYPE_UPDATE_JOB)).Return(nil).Once()
+		defer clusterManager.AssertExpectations(t)
+
+		planner := NewPlanner(log.NewNoop(), clusterManager, runRepoFac, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+		assert.Nil(t, planner.cancelRuns(ctx))
+	})
+	t.Run("releaseDepartedPeers", func(t *testing.T) {
+		runningRunID := uuid.Must(uuid.NewRandom())
+		cancellingRunID := uuid.Must(uuid.NewRandom())
+		aliveRunID := uuid.Must(uuid.NewRandom())
+
+		runRepo := new(mock.JobRunRepository)
+		runRepo.On("Clear", ctx, runningRunID).Return(nil).Once()
+		defer runRepo.AssertExpectations(t)
+		runRepoFac := new(mock.JobRunRepoFactory)
+		runRepoFac.On("New").Return(runRepo)
+
+		aliveAlloc := set.NewHashSet()
+		aliveAlloc.Add(gossip.StateJob{UUID: aliveRunID.String(), Status: models.RunStateRunning.String()})
+		departedAlloc := set.NewHashSet()
+		departedAlloc.Add(gossip.StateJob{UUID: runningRunID.String(), Status: models.RunStateRunning.String()})
+		departedAlloc.Add(gossip.StateJob{UUID: cancellingRunID.String(), Status: models.RunStateCancelling.String()})
+		clusterManager := new(mock.ClusterManager)
+		clusterManager.On("GetClusterMembers").Return([]serf.Member{
+			{Name: "node-1", Status: serf.StatusAlive},
+			{Name: "node-2", Status: serf.StatusFailed},
+		})
+		clusterManager.On("GetState").Return(gossip.State{
+			Allocation: map[string]set.Set{
+				"node-1": aliveAlloc,
+				"node-2": departedAlloc,
+			},
+		})
+		clusterManager.On("ApplyCommand", mock2.MatchedBy(func(cmd *pb.CommandLog) bool {
+			if cmd.Type != pb.CommandLog_COMMAND_TYPE_RELEASE_JOB {
+				return false
+			}
+			releaseCmd := &pb.CommandReleaseJob{}
+			if err := proto.Unmarshal(cmd.Payload, releaseCmd); err != nil {
+				return false
+			}
+			return releaseCmd.PeerId == "node-2" && len(releaseCmd.RunIds) == 2
+		})).Return(nil).Once()
+		defer clusterManager.AssertExpectations(t)
+
+		planner := NewPlanner(log.NewNoop(), clusterManager, runRepoFac, nil, nil, nil, nil, nil, nil, nil, nil, nil, nowFn)
+		assert.Nil(t, planner.releaseDepartedPeers(ctx))
+	})
+	t.Run("stopCancelledRuns", func(t *testing.T) {
+		runningInstance := models.InstanceSpec{
+			ID:     uuid.Must(uuid.NewRandom()),
+			Name:   "bq2bq",
+			Type:   models.InstanceTypeTask,
+			Status: models.RunStateRunning,
+		}
+		cancellingRun := models.JobRun{
+			ID:     uuid.Must(uuid.NewRandom()),
+			Spec:   models.JobSpec{Name: "job"},
+			Status: models.RunStateCancelling,
+			Instances: []models.InstanceSpec{
+				runningInstance,
+				{
+					ID:     uuid.Must(uuid.NewRandom()),
+					Name:   "transporter",
+					Type:   models.InstanceTypeHook,
+					Status: models.RunStateSuccess,
+				},
+			},
+		}
+		executingRun := models.JobRun{
+			ID:     uuid.Must(uuid.NewRandom()),
+			Status: models.RunStateRunning,
+		}
+
+		runRepo := new(mock.JobRunRepository)
+		runRepo.On("GetByID", ctx, cancellingRun.ID).Return(cancellingRun, namespaceSpec, nil)
+		defer runRepo.AssertExpectations(t)
+		runRepoFac := new(mock.JobRunRepoFactory)
+		runRepoFac.On("New").Return(runRepo)
+
+		nodeAlloc := set.NewHashSet()
+		nodeAlloc.Add(gossip.StateJob{UUID: cancellingRun.ID.String(), Status: models.RunStateCancelling.String()})
+		nodeAlloc.Add(gossip.StateJob{UUID: executingRun.ID.String(), Status: models.RunStateRunning.String()})
+		clusterManager := new(mock.ClusterManager)
+		clusterManager.On("GetLocalMember").Return(serf.Member{Name: "node-1"})
+		clusterManager.On("GetState").Return(gossip.State{
+			Allocation: map[string]set.Set{
+				"node-1": nodeAlloc,
+			},
+		})
+
+		executor := new(mock.Executor)
+		executor.On("Stop", ctx, models.ExecutorStopRequest{
+			ID:     runningInstance.ID.String(),
+			Signal: "SIGTERM",
+		}).Return(nil)
+		defer executor.AssertExpectations(t)
+
+		planner := NewPlanner(log.NewNoop(), clusterManager, runRepoFac, nil, nil, nil, nil, nil, nil, nil, executor, nil, nowFn)
+		assert.Nil(t, planner.stopCancelledRuns(ctx))
+		assert.True(t, planner.isRunCancelled(cancellingRun.ID))
+		assert.False(t, planner.isRunCancelled(executingRun.ID))
+	})
+	t.Run("inferRunState", func(t *testing.T) {
+		newHook := func(name string, hookType models.HookType) models.JobSpecHook {
+			unit := new(mock.BasePlugin)
+			unit.On("PluginInfo").Return(&models.PluginInfoResponse{
+				Name:     name,
+				HookType: hookType,
+			}, nil)
+			return models.JobSpecHook{Unit: &models.Plugin{Base: unit}}
+		}
+		jobSpec := models.JobSpec{
+			Hooks: []models.JobSpecHook{
+				newHook("transporter", models.HookTypePre),
+				newHook("predator", models.HookTypePost),
+				newHook("notify", models.HookTypeFail),
+			},
+		}
+		task := func(state models.JobRunState) models.InstanceSpec {
+			return models.InstanceSpec{Name: "bq2bq", Type: models.InstanceTypeTask, Status: state}
+		}
+		hook := func(name string, state models.JobRunState) models.InstanceSpec {
+			return models.InstanceSpec{Name: name, Type: models.InstanceTypeHook, Status: state}
+		}
+		cases := []struct {
+			name      string
+			instances []models.InstanceSpec
+			expected  models.JobRunState
+		}{
+			{"no instances", nil, models.RunStateAccepted},
+			{"pre hook finished", []models.InstanceSpec{hook("transporter", models.RunStateSuccess)}, models.RunStateRunning},
+			{"post hook pending", []models.InstanceSpec{hook("transporter", models.RunStateSuccess), task(models.RunStateSuccess)}, models.RunStateRunning},
+			{"post hook finished", []models.InstanceSpec{hook("transporter", models.RunStateSuccess), task(models.RunStateSuccess),
+				hook("predator", models.RunStateSuccess)}, models.RunStateSuccess},
+			{"fail hook pending", []models.InstanceSpec{hook("transporter", models.RunStateFailed)}, models.RunStateRunning},
+			{"fail hook finished", []models.InstanceSpec{hook("transporter", models.RunStateSuccess), task(models.RunStateFailed),
+				hook("notify", models.RunStateSuccess)}, models.RunStateFailed},
+			{"fail hook skipped", []models.InstanceSpec{hook("transporter", models.RunStateFailed),
+				hook("notify", models.RunStateFailed)}, models.RunStateFailed},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				assert.Equal(t, c.expected, inferRunState(models.JobRun{
+					Spec:      jobSpec,
+					Status:    models.RunStateAccepted,
+					Instances: c.instances,
+				}))
+			})
+		}
+	})
+	t.Run("retryDelay", func(t *testing.T) {
+		t.Run("should use constant delay without exponential backoff", func(t *testing.T) {
+			retry := models.JobSpecBehaviorRetry{Count: 3, Delay: time.Minute}
+			assert.Equal(t, time.Minute, retryDelay(retry, 1))
+			assert.Equal(t, time.Minute, retryDelay(retry, 3))
+		})
+		t.Run("should double delay on every attempt with exponential backoff", func(t *testing.T) {
+			retry := models.JobSpecBehaviorRetry{Count: 3, Delay: time.Minute, ExponentialBackoff: true}
+			assert.Equal(t, time.Minute, retryDelay(retry, 1))
+			assert.Equal(t, time.Minute*2, retryDelay(retry, 2))
+			assert.Equal(t, time.Minute*4, retryDelay(retry, 3))
+		})
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
 
 	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/models"
@@ -19,9 +20,15 @@ type InstanceRepoFactory interface {
 	New() store.InstanceRepository
 }
 
+// ProjectJobSpecRepoFactory is used to find job specs at project level
+type ProjectJobSpecRepoFactory interface {
+	New(proj models.ProjectSpec) store.ProjectJobSpecRepository
+}
+
 type Scheduler struct {
-	jobRunRepoFac RunRepoFactory
-	Now           func() time.Time
+	jobRunRepoFac         RunRepoFactory
+	projectJobSpecRepoFac ProjectJobSpecRepoFactory
+	Now                   func() time.Time
 }
 
 func (s *Scheduler) GetName() string {
@@ -32,8 +39,22 @@ func (s *Scheduler) VerifyJob(ctx context.Context, namespace models.NamespaceSpe
 	return nil
 }
 
+// ListJobs returns all the jobs which were executed at least once in the
+// namespace. Jobs are not compiled for this scheduler so only names are
+// populated irrespective of the list options
 func (s *Scheduler) ListJobs(ctx context.Context, namespace models.NamespaceSpec, opts models.SchedulerListOptions) ([]models.Job, error) {
-	panic("implement me")
+	jobRuns, err := s.jobRunRepoFac.New().GetLatestByNamespace(ctx, namespace.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []models.Job
+	for _, jobRun := range jobRuns {
+		jobs = append(jobs, models.Job{
+			Name: jobRun.Spec.Name,
+		})
+	}
+	return jobs, nil
 }
 
 func (s *Scheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSpec, jobs []models.JobSpec, obs progress.Observer) error {
@@ -64,20 +85,112 @@ func (s *Scheduler) Bootstrap(ctx context.Context, spec models.ProjectSpec) erro
 }
 
 func (s *Scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus, error) {
-	panic("implement me")
+	return s.getJobRunStatus(ctx, projSpec, jobName, time.Time{}, s.Now())
 }
 
 func (s *Scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
 	return nil
 }
 
+// GetJobRunStatus reads runs directly from the store so batchSize is not
+// needed for pagination
 func (s *Scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time, endDate time.Time, batchSize int) ([]models.JobStatus, error) {
-	panic("implement me")
+	return s.getJobRunStatus(ctx, projectSpec, jobName, startDate, endDate)
 }
 
-func NewScheduler(jobRunRepoFac RunRepoFactory, nowFn func() time.Time) *Scheduler {
+// Pause needs no action as paused flag of job is persisted with its spec
+// and planner doesn't materialize runs of paused jobs
+func (s *Scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return nil
+}
+
+// Resume needs no action, planner picks up the job again once it is
+// persisted as not paused
+func (s *Scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return nil
+}
+
+// TriggerRun queues a manual run of the job for provided scheduled time,
+// runs of this scheduler don't accept any configuration
+func (s *Scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	if len(config) > 0 {
+		return errors.Errorf("run configuration is not supported by %s scheduler", s.GetName())
+	}
+	jobSpec, _, err := s.projectJobSpecRepoFac.New(namespace.ProjectSpec).GetByName(ctx, jobName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find job %s", jobName)
+	}
+	return s.jobRunRepoFac.New().Save(ctx, namespace, models.JobRun{
+		Spec:        jobSpec,
+		Trigger:     models.TriggerManual,
+		ScheduledAt: scheduledAt,
+	})
+}
+
+func (s *Scheduler) getJobRunStatus(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate time.Time, endDate time.Time) ([]models.JobStatus, error) {
+	jobSpec, _, err := s.projectJobSpecRepoFac.New(projectSpec).GetByName(ctx, jobName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find job %s", jobName)
+	}
+
+	jobRuns, err := s.jobRunRepoFac.New().GetByJob(ctx, jobSpec.ID, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch runs of job %s", jobName)
+	}
+
+	var jobStatus []models.JobStatus
+	for _, jobRun := range jobRuns {
+		jobStatus = append(jobStatus, models.JobStatus{
+			ScheduledAt: jobRun.ScheduledAt,
+			State:       jobRun.Status,
+		})
+	}
+	return jobStatus, nil
+}
+
+func NewScheduler(jobRunRepoFac RunRepoFactory, projectJobSpecRepoFac ProjectJobSpecRepoFactory, nowFn func() time.Time) *Scheduler {
 	return &Scheduler{
-		jobRunRepoFac: jobRunRepoFac,
-		Now:           nowFn,
+		jobRunRepoFac:         jobRunRepoFac,
+		projectJobSpecRepoFac: projectJobSpecRepoFac,
+		Now:                   nowFn,
+	}
+}
+
+// BatchScheduler executes deployed jobs as per their schedule. Runs are
+// materialized by the cluster planner from stored job specs so deployment
+// only needs to record priority weights of jobs
+type BatchScheduler struct {
+	*Scheduler
+	jobSpecRepoFac JobSpecRepoFactory
+}
+
+// DeployJobs stores priority weight resolved for each job, planner uses it
+// to decide which runs should be allocated first
+func (s *BatchScheduler) DeployJobs(ctx context.Context, namespace models.NamespaceSpec, jobs []models.JobSpec, obs progress.Observer) error {
+	jobSpecRepo := s.jobSpecRepoFac.New(namespace)
+	for _, j := range jobs {
+		// provided specs have their dependencies resolved, those should not
+		// be stored back so update the spec as defined by user
+		storedSpec, err := jobSpecRepo.GetByName(ctx, j.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find job %s", j.Name)
+		}
+		if storedSpec.Task.Priority == j.Task.Priority {
+			continue
+		}
+		storedSpec.Task.Priority = j.Task.Priority
+		if err := jobSpecRepo.Save(ctx, storedSpec); err != nil {
+			return errors.Wrapf(err, "failed to save priority of job %s", j.Name)
+		}
+	}
+	return nil
+}
+
+func NewBatchScheduler(jobRunRepoFac RunRepoFactory, projectJobSpecRepoFac ProjectJobSpecRepoFactory,
+	jobSpecRepoFac JobSpecRepoFactory, nowFn func() time.Time) *BatchScheduler {
+	return &BatchScheduler{
+		Scheduler:      NewScheduler(jobRunRepoFac, projectJobSpecRepoFac, nowFn),
+		jobSpecRepoFac: jobSpecRepoFac,
 	}
 }
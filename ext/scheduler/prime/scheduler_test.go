@@ -0,0 +1,165 @@
+package prime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/ext/scheduler/prime"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "ns",
+		ProjectSpec: projectSpec,
+	}
+	jobSpec := models.JobSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "job-1",
+	}
+	now := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	t.Run("ListJobs", func(t *testing.T) {
+		t.Run("should return names of jobs executed in namespace", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetLatestByNamespace", ctx, namespaceSpec.ID).Return([]models.JobRun{
+				{Spec: jobSpec},
+				{Spec: models.JobSpec{Name: "job-2"}},
+			}, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(runRepoFac, nil, nowFn)
+			jobs, err := scheduler.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{OnlyName: true})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.Job{{Name: "job-1"}, {Name: "job-2"}}, jobs)
+		})
+		t.Run("should fail if runs can't be fetched", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetLatestByNamespace", ctx, namespaceSpec.ID).Return([]models.JobRun{}, errors.New("db down"))
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(runRepoFac, nil, nowFn)
+			_, err := scheduler.ListJobs(ctx, namespaceSpec, models.SchedulerListOptions{})
+			assert.Equal(t, "db down", err.Error())
+		})
+	})
+	t.Run("GetJobStatus", func(t *testing.T) {
+		t.Run("should return status of all the runs of job till now", func(t *testing.T) {
+			jobRuns := []models.JobRun{
+				{
+					Spec:        jobSpec,
+					Status:      models.RunStateSuccess,
+					ScheduledAt: now.Add(-time.Hour * 48),
+				},
+				{
+					Spec:        jobSpec,
+					Status:      models.RunStateRunning,
+					ScheduledAt: now.Add(-time.Hour * 24),
+				},
+			}
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, jobSpec.ID, time.Time{}, now).Return(jobRuns, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(runRepoFac, projJobSpecRepoFac, nowFn)
+			status, err := scheduler.GetJobStatus(ctx, projectSpec, jobSpec.Name)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{ScheduledAt: jobRuns[0].ScheduledAt, State: models.RunStateSuccess},
+				{ScheduledAt: jobRuns[1].ScheduledAt, State: models.RunStateRunning},
+			}, status)
+		})
+		t.Run("should fail if job is not found in project", func(t *testing.T) {
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(nil, errors.New("not found"))
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(nil, projJobSpecRepoFac, nowFn)
+			_, err := scheduler.GetJobStatus(ctx, projectSpec, jobSpec.Name)
+			assert.Equal(t, "failed to find job job-1: not found", err.Error())
+		})
+	})
+	t.Run("GetJobRunStatus", func(t *testing.T) {
+		t.Run("should return status of runs between provided dates", func(t *testing.T) {
+			startDate := now.Add(-time.Hour * 24 * 7)
+			endDate := now.Add(-time.Hour * 24)
+			jobRuns := []models.JobRun{
+				{
+					Spec:        jobSpec,
+					Status:      models.RunStateFailed,
+					ScheduledAt: endDate,
+				},
+			}
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, jobSpec.ID, startDate, endDate).Return(jobRuns, nil)
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(runRepoFac, projJobSpecRepoFac, nowFn)
+			status, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobSpec.Name, startDate, endDate, 100)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.JobStatus{
+				{ScheduledAt: endDate, State: models.RunStateFailed},
+			}, status)
+		})
+		t.Run("should fail if runs can't be fetched", func(t *testing.T) {
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByJob", ctx, jobSpec.ID, now, now).Return([]models.JobRun{}, errors.New("db down"))
+			defer runRepo.AssertExpectations(t)
+			runRepoFac := new(mock.JobRunRepoFactory)
+			runRepoFac.On("New").Return(runRepo)
+			defer runRepoFac.AssertExpectations(t)
+
+			scheduler := prime.NewScheduler(runRepoFac, projJobSpecRepoFac, nowFn)
+			_, err := scheduler.GetJobRunStatus(ctx, projectSpec, jobSpec.Name, now, now, 100)
+			assert.Equal(t, "failed to fetch runs of job job-1: db down", err.Error())
+		})
+	})
+}
@@ -0,0 +1,123 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/robfig/cron/v3"
+)
+
+var (
+	driftedJobsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "job_drift",
+		Help: "Number of jobs on scheduler which are out of sync with optimus",
+	}, []string{"project", "namespace", "type"})
+)
+
+// DriftReconcilerConfig configures periodic drift detection
+type DriftReconcilerConfig struct {
+	// Interval is a cron spec for how often drift is checked, e.g. @every 1h
+	Interval string
+	// Timeout of a complete pass over all the namespaces
+	Timeout time.Duration
+	// Repair fixes the detected drift instead of only reporting it
+	Repair bool
+}
+
+// DriftReconciler periodically looks for jobs deployed on batch scheduler
+// which are out of sync with the specs stored in optimus
+type DriftReconciler struct {
+	l                    log.Logger
+	config               DriftReconcilerConfig
+	jobService           models.JobService
+	projectRepoFactory   ProjectRepoFactory
+	namespaceRepoFactory NamespaceRepoFactory
+	cronScheduler        *cron.Cron
+}
+
+// Reconcile checks drift of every namespace of all the registered projects
+func (r *DriftReconciler) Reconcile(ctx context.Context) error {
+	projectSpecs, err := r.projectRepoFactory.New().GetAll(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch projects")
+	}
+
+	var reconcileErrors error
+	for _, projectSpec := range projectSpecs {
+		namespaceSpecs, err := r.namespaceRepoFactory.New(projectSpec).GetAll(ctx)
+		if err != nil {
+			reconcileErrors = multierror.Append(reconcileErrors, errors.Wrapf(err, "failed to fetch namespaces of %s", projectSpec.Name))
+			continue
+		}
+		for _, namespaceSpec := range namespaceSpecs {
+			// a namespace failing to sync should not stop others from being checked
+			drift, err := r.jobService.GetDrift(ctx, namespaceSpec, r.config.Repair)
+			if err != nil {
+				reconcileErrors = multierror.Append(reconcileErrors, errors.Wrapf(err, "failed to check drift of namespace %s", namespaceSpec.Name))
+				continue
+			}
+			r.report(projectSpec, namespaceSpec, drift)
+		}
+	}
+	return reconcileErrors
+}
+
+func (r *DriftReconciler) report(projectSpec models.ProjectSpec, namespaceSpec models.NamespaceSpec, drift models.JobDrift) {
+	driftedJobsGauge.WithLabelValues(projectSpec.Name, namespaceSpec.Name, "orphaned").Set(float64(len(drift.Orphaned)))
+	driftedJobsGauge.WithLabelValues(projectSpec.Name, namespaceSpec.Name, "missing").Set(float64(len(drift.Missing)))
+	driftedJobsGauge.WithLabelValues(projectSpec.Name, namespaceSpec.Name, "modified").Set(float64(len(drift.Modified)))
+	if !drift.HasDrift() {
+		return
+	}
+	r.l.Warn("scheduler is out of sync", "project", projectSpec.Name, "namespace", namespaceSpec.Name,
+		"orphaned", drift.Orphaned, "missing", drift.Missing, "modified", drift.Modified, "repaired", drift.Repaired)
+}
+
+func (r *DriftReconciler) reconcileOnSchedule() {
+	r.l.Debug("start checking drift of jobs...")
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.Timeout)
+	defer cancel()
+	if err := r.Reconcile(ctx); err != nil {
+		r.l.Error("failed to reconcile drift of jobs", "error", err)
+	}
+	r.l.Debug("drift of jobs checked")
+}
+
+// Init starts checking drift as per the configured interval, it is a noop
+// if interval is not configured
+func (r *DriftReconciler) Init() error {
+	if r.config.Interval == "" {
+		return nil
+	}
+	if _, err := r.cronScheduler.AddFunc(r.config.Interval, r.reconcileOnSchedule); err != nil {
+		return errors.Wrapf(err, "invalid drift check interval %s", r.config.Interval)
+	}
+	r.cronScheduler.Start()
+	return nil
+}
+
+// Close waits for an ongoing check to finish
+func (r *DriftReconciler) Close() error {
+	<-r.cronScheduler.Stop().Done()
+	return nil
+}
+
+func NewDriftReconciler(l log.Logger, config DriftReconcilerConfig, jobService models.JobService,
+	projectRepoFactory ProjectRepoFactory, namespaceRepoFactory NamespaceRepoFactory) *DriftReconciler {
+	return &DriftReconciler{
+		l:                    l,
+		config:               config,
+		jobService:           jobService,
+		projectRepoFactory:   projectRepoFactory,
+		namespaceRepoFactory: namespaceRepoFactory,
+		cronScheduler: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
@@ -0,0 +1,71 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/salt/log"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriftReconciler(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "proj",
+	}
+	namespaceSpecs := []models.NamespaceSpec{
+		{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "ns-failing",
+			ProjectSpec: projectSpec,
+		},
+		{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "ns-drifted",
+			ProjectSpec: projectSpec,
+		},
+	}
+
+	t.Run("Reconcile", func(t *testing.T) {
+		t.Run("should check drift of every namespace even if one of them fails", func(t *testing.T) {
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll", ctx).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepo.AssertExpectations(t)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetAll", ctx).Return(namespaceSpecs, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetDrift", ctx, namespaceSpecs[0], true).Return(models.JobDrift{}, errors.New("scheduler unreachable"))
+			jobService.On("GetDrift", ctx, namespaceSpecs[1], true).Return(models.JobDrift{
+				Orphaned: []string{"job-1"},
+				Repaired: true,
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			reconciler := job.NewDriftReconciler(log.NewNoop(), job.DriftReconcilerConfig{Repair: true},
+				jobService, projectRepoFac, namespaceRepoFac)
+			err := reconciler.Reconcile(ctx)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "failed to check drift of namespace ns-failing: scheduler unreachable")
+		})
+	})
+	t.Run("Init", func(t *testing.T) {
+		t.Run("should fail for invalid interval", func(t *testing.T) {
+			reconciler := job.NewDriftReconciler(log.NewNoop(), job.DriftReconcilerConfig{Interval: "every now and then"},
+				nil, nil, nil)
+			assert.NotNil(t, reconciler.Init())
+		})
+	})
+}
@@ -12,4 +12,6 @@ type SpecRepository interface {
 	GetByName(context.Context, string) (models.JobSpec, error)
 	GetAll(context.Context) ([]models.JobSpec, error)
 	Delete(context.Context, string) error
+	// SetPaused updates only the paused flag of the job
+	SetPaused(ctx context.Context, name string, paused bool) error
 }
@@ -31,14 +31,44 @@ func (srv *Service) prepareJobSpecMap(ctx context.Context, replayRequest models.
 	return jobSpecMap, nil
 }
 
+// prepareJobNamespaceMap maps jobs of namespaces used by the replay filter
+// to their namespace name
+func (srv *Service) prepareJobNamespaceMap(ctx context.Context, filter models.ReplayFilter) (map[string]string, error) {
+	if len(filter.IncludeNamespaces) == 0 && len(filter.ExcludeNamespaces) == 0 {
+		return nil, nil
+	}
+	jobNamespaceMap := make(map[string]string)
+	namespaces := append(append([]models.NamespaceSpec{}, filter.IncludeNamespaces...), filter.ExcludeNamespaces...)
+	for _, namespace := range namespaces {
+		jobSpecs, err := srv.jobSpecRepoFactory.New(namespace).GetAll(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve jobs of namespace %s", namespace.Name)
+		}
+		for _, jobSpec := range jobSpecs {
+			jobNamespaceMap[jobSpec.Name] = namespace.Name
+		}
+	}
+	return jobNamespaceMap, nil
+}
+
 func (srv *Service) ReplayDryRun(ctx context.Context, replayRequest models.ReplayRequest) (*tree.TreeNode, error) {
 	jobSpecMap, err := srv.prepareJobSpecMap(ctx, replayRequest)
 	if err != nil {
 		return nil, err
 	}
 	replayRequest.JobSpecMap = jobSpecMap
+	if replayRequest.JobNamespaceMap, err = srv.prepareJobNamespaceMap(ctx, replayRequest.Filter); err != nil {
+		return nil, err
+	}
 
-	return prepareReplayExecutionTree(replayRequest)
+	rootNode, err := prepareReplayExecutionTree(replayRequest)
+	if err != nil {
+		return nil, err
+	}
+	if replayRequest.FailedRunsOnly {
+		return pruneToFailedRuns(ctx, replayRequest.Project, rootNode, replayRequest.FailedRunsDownstream, srv.replayManager.GetRunStatus)
+	}
+	return rootNode, nil
 }
 
 func (srv *Service) Replay(ctx context.Context, replayRequest models.ReplayRequest) (string, error) {
@@ -47,6 +77,9 @@ func (srv *Service) Replay(ctx context.Context, replayRequest models.ReplayReque
 		return "", err
 	}
 	replayRequest.JobSpecMap = jobSpecMap
+	if replayRequest.JobNamespaceMap, err = srv.prepareJobNamespaceMap(ctx, replayRequest.Filter); err != nil {
+		return "", err
+	}
 
 	replayUUID, err := srv.replayManager.Replay(ctx, replayRequest)
 	if err != nil {
@@ -78,15 +111,78 @@ func prepareReplayExecutionTree(replayRequest models.ReplayRequest) (*tree.TreeN
 	if err != nil {
 		return nil, err
 	}
+	if !replayRequest.Filter.IsEmpty() {
+		rootInstance = filterDownstreamDAGs(rootInstance, replayRequest.Filter, replayRequest.JobNamespaceMap)
+	}
 
 	rootInstance, err = populateDownstreamRuns(rootInstance)
 	if err != nil {
 		return nil, err
 	}
+	if replayRequest.Filter.HasIncludes() {
+		removeRunsNotIncluded(rootInstance, replayRequest.Filter, replayRequest.JobNamespaceMap)
+	}
 
 	return rootInstance, nil
 }
 
+// filterDownstreamDAGs builds a copy of the execution tree without the
+// downstream excluded by filter. Downstream of an excluded job is skipped as
+// well since its input doesn't change, depth of a job is its shortest distance
+// from the root
+func filterDownstreamDAGs(rootNode *tree.TreeNode, filter models.ReplayFilter, jobNamespaceMap map[string]string) *tree.TreeNode {
+	filteredRoot := tree.NewTreeNode(rootNode.Data)
+	for _, run := range rootNode.Runs.Values() {
+		filteredRoot.Runs.Add(run)
+	}
+	if filter.RootOnly {
+		return filteredRoot
+	}
+
+	filteredNodes := map[string]*tree.TreeNode{rootNode.GetName(): filteredRoot}
+	depths := map[string]int{rootNode.GetName(): 0}
+	nodesQueue := []*tree.TreeNode{rootNode}
+	for len(nodesQueue) != 0 {
+		node := nodesQueue[0]
+		nodesQueue = nodesQueue[1:]
+		if filter.MaxDepth > 0 && depths[node.GetName()] >= filter.MaxDepth {
+			continue
+		}
+
+		for _, dependent := range node.Dependents {
+			jobSpec := dependent.Data.(models.JobSpec)
+			if filter.Excludes(jobSpec, jobNamespaceMap[jobSpec.Name]) {
+				continue
+			}
+			filteredDependent, ok := filteredNodes[jobSpec.Name]
+			if !ok {
+				filteredDependent = tree.NewTreeNode(jobSpec)
+				filteredNodes[jobSpec.Name] = filteredDependent
+				depths[jobSpec.Name] = depths[node.GetName()] + 1
+				nodesQueue = append(nodesQueue, dependent)
+			}
+			filteredNodes[node.GetName()].AddDependent(filteredDependent)
+		}
+	}
+	return filteredRoot
+}
+
+// removeRunsNotIncluded clears runs of downstream jobs which aren't included
+// by the filter, these are kept in the tree only if some job downstream of
+// them is included
+func removeRunsNotIncluded(rootNode *tree.TreeNode, filter models.ReplayFilter, jobNamespaceMap map[string]string) {
+	for _, node := range rootNode.GetAllNodes() {
+		if node == rootNode {
+			continue
+		}
+		jobSpec := node.Data.(models.JobSpec)
+		if !filter.Includes(jobSpec, jobNamespaceMap[jobSpec.Name]) {
+			node.Runs = set.NewTreeSetWithTimeComparator()
+		}
+	}
+	removeNodesWithoutRuns(rootNode)
+}
+
 func findOrCreateDAGNode(dagTree *tree.MultiRootTree, dagSpec models.JobSpec) *tree.TreeNode {
 	node, ok := dagTree.GetNodeByName(dagSpec.Name)
 	if !ok {
@@ -98,42 +194,124 @@ func findOrCreateDAGNode(dagTree *tree.MultiRootTree, dagSpec models.JobSpec) *t
 
 func populateDownstreamRuns(parentNode *tree.TreeNode) (*tree.TreeNode, error) {
 	for idx, childNode := range parentNode.Dependents {
-		childDag := childNode.Data.(models.JobSpec)
-		taskSchedule, err := cron.ParseCronSchedule(childDag.Schedule.Interval)
+		runs, err := getDependentRuns(parentNode.Runs.Values(), childNode.Data.(models.JobSpec))
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range runs {
+			childNode.Runs.Add(run)
+		}
+		updatedChildNode, err := populateDownstreamRuns(childNode)
 		if err != nil {
 			return nil, err
 		}
+		parentNode.Dependents[idx] = updatedChildNode
+	}
+	return parentNode, nil
+}
 
-		for _, parentRunDateRaw := range parentNode.Runs.Values() { //
-			parentRunDate := parentRunDateRaw.(time.Time)
+// getDependentRuns provides runs of the child dag affected by the parent runs
+func getDependentRuns(parentRuns []interface{}, childDag models.JobSpec) ([]time.Time, error) {
+	taskSchedule, err := cron.ParseCronSchedule(childDag.Schedule.Interval)
+	if err != nil {
+		return nil, err
+	}
 
-			// subtract 1 day to make end inclusive
-			parentEndDate := parentRunDate.Add(time.Hour * -24).Add(childDag.Task.Window.Size)
+	var dependentRuns []time.Time
+	for _, parentRunDateRaw := range parentRuns { //
+		parentRunDate := parentRunDateRaw.(time.Time)
 
-			// subtracting 1 sec to accommodate next call of cron
-			// where parent task and current task has same scheduled interval
-			taskFirstEffectedRun := taskSchedule.Next(parentRunDate.Add(-1 * time.Second))
+		// subtract 1 day to make end inclusive
+		parentEndDate := parentRunDate.Add(time.Hour * -24).Add(childDag.Task.Window.Size)
 
-			//make sure it is after current dag start date
-			if taskFirstEffectedRun.Before(childDag.Schedule.StartDate) {
-				continue
-			}
+		// subtracting 1 sec to accommodate next call of cron
+		// where parent task and current task has same scheduled interval
+		taskFirstEffectedRun := taskSchedule.Next(parentRunDate.Add(-1 * time.Second))
+
+		//make sure it is after current dag start date
+		if taskFirstEffectedRun.Before(childDag.Schedule.StartDate) {
+			continue
+		}
 
-			runs, err := getRunsBetweenDates(parentRunDate, parentEndDate, childDag.Schedule.Interval)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to find runs with parent dag")
+		runs, err := getRunsBetweenDates(parentRunDate, parentEndDate, childDag.Schedule.Interval)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find runs with parent dag")
+		}
+		dependentRuns = append(dependentRuns, runs...)
+	}
+	return dependentRuns, nil
+}
+
+// runStatusGetter provides status of runs of a job scheduled between the dates
+type runStatusGetter func(ctx context.Context, projectSpec models.ProjectSpec, startDate time.Time, endDate time.Time,
+	jobName string) ([]models.JobStatus, error)
+
+// pruneToFailedRuns keeps only the runs of the execution tree which failed in
+// scheduler, runs affected by them are kept as well if withDownstream is set.
+// Downstream jobs left without any run in their subtree are removed
+func pruneToFailedRuns(ctx context.Context, projectSpec models.ProjectSpec, rootNode *tree.TreeNode, withDownstream bool,
+	getRunStatus runStatusGetter) (*tree.TreeNode, error) {
+	originalRuns := make(map[*tree.TreeNode]set.Set)
+	for _, node := range rootNode.GetAllNodes() {
+		if _, ok := originalRuns[node]; ok || node.Runs.Size() == 0 {
+			continue
+		}
+		runs := node.Runs.Values()
+		jobStatusList, err := getRunStatus(ctx, projectSpec, runs[0].(time.Time), runs[len(runs)-1].(time.Time), node.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get run status of job %s", node.GetName())
+		}
+
+		originalRuns[node] = node.Runs
+		failedRuns := set.NewTreeSetWithTimeComparator()
+		for _, jobStatus := range jobStatusList {
+			if jobStatus.State == models.RunStateFailed && node.Runs.Contains(jobStatus.ScheduledAt) {
+				failedRuns.Add(jobStatus.ScheduledAt)
 			}
-			for _, run := range runs {
+		}
+		node.Runs = failedRuns
+	}
+
+	if withDownstream {
+		if err := addFailedDownstreamRuns(rootNode, originalRuns); err != nil {
+			return nil, err
+		}
+	}
+	removeNodesWithoutRuns(rootNode)
+	return rootNode, nil
+}
+
+// addFailedDownstreamRuns adds runs of dependents affected by the runs kept
+// in the parent, only the runs which were part of the replay are added
+func addFailedDownstreamRuns(parentNode *tree.TreeNode, originalRuns map[*tree.TreeNode]set.Set) error {
+	for _, childNode := range parentNode.Dependents {
+		runs, err := getDependentRuns(parentNode.Runs.Values(), childNode.Data.(models.JobSpec))
+		if err != nil {
+			return err
+		}
+		for _, run := range runs {
+			if childRuns, ok := originalRuns[childNode]; ok && childRuns.Contains(run) {
 				childNode.Runs.Add(run)
 			}
 		}
-		updatedChildNode, err := populateDownstreamRuns(childNode)
-		if err != nil {
-			return nil, err
+		if err := addFailedDownstreamRuns(childNode, originalRuns); err != nil {
+			return err
 		}
-		parentNode.Dependents[idx] = updatedChildNode
 	}
-	return parentNode, nil
+	return nil
+}
+
+// removeNodesWithoutRuns removes dependents having no run in their subtree,
+// reports if the node is left without any run in its subtree
+func removeNodesWithoutRuns(node *tree.TreeNode) bool {
+	dependents := make([]*tree.TreeNode, 0)
+	for _, dependent := range node.Dependents {
+		if !removeNodesWithoutRuns(dependent) {
+			dependents = append(dependents, dependent)
+		}
+	}
+	node.Dependents = dependents
+	return len(dependents) == 0 && node.Runs.Size() == 0
 }
 
 // getRunsBetweenDates provides execution runs from start to end following a schedule interval
@@ -179,6 +357,11 @@ func (srv *Service) GetReplayStatus(ctx context.Context, replayRequest models.Re
 	}, nil
 }
 
+// CancelReplay stops the replay if it is still in progress
+func (srv *Service) CancelReplay(ctx context.Context, replayRequest models.ReplayRequest, failRuns bool) error {
+	return srv.replayManager.Cancel(ctx, replayRequest.Project, replayRequest.ID, failRuns)
+}
+
 func TimeOfJobStatusComparator(a, b interface{}) int {
 	aAsserted := a.(models.JobStatus).ScheduledAt
 	bAsserted := b.(models.JobStatus).ScheduledAt
@@ -0,0 +1,170 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/odpf/optimus/core/cron"
+	"github.com/odpf/optimus/core/tree"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// ReplayBatchConfig splits clearing of replay runs into batches so the
+// scheduler isn't flooded with every run of a large replay at once. Runs of
+// a batch are cleared only after runs of the previous batch are finished.
+// Replays are cleared in a single batch if neither Size nor Window is set
+type ReplayBatchConfig struct {
+	// Size is the number of runs of the replayed job per batch, dependent
+	// runs scheduled within the same time range go in the same batch
+	Size int
+
+	// Window is the schedule time range covered by a batch, takes
+	// precedence over Size
+	Window time.Duration
+}
+
+// replayBatch holds runs scheduled from start till end, end is exclusive
+type replayBatch struct {
+	start time.Time
+	end   time.Time
+}
+
+// runs of the tree node which belong to the batch
+func (b replayBatch) runs(treeNode *tree.TreeNode) []time.Time {
+	var runs []time.Time
+	for _, run := range treeNode.Runs.Values() {
+		runTime := run.(time.Time)
+		if !runTime.Before(b.start) && runTime.Before(b.end) {
+			runs = append(runs, runTime)
+		}
+	}
+	return runs
+}
+
+// runGroups splits runs of the tree node which belong to the batch into
+// groups of consecutive schedule ticks of the job, so ticks which are not
+// part of the replay are left out when runs are cleared in ranges
+func (b replayBatch) runGroups(treeNode *tree.TreeNode) ([][]time.Time, error) {
+	runs := b.runs(treeNode)
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	schedule, err := cron.ParseCronSchedule(treeNode.Data.(models.JobSpec).Schedule.Interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse schedule of job %s", treeNode.GetName())
+	}
+
+	var groups [][]time.Time
+	for idx, run := range runs {
+		if idx == 0 || !schedule.Next(runs[idx-1]).Equal(run) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], run)
+	}
+	return groups, nil
+}
+
+// batches splits runs of the replay execution tree, batches without any
+// run are skipped
+func (c ReplayBatchConfig) batches(replaySpec models.ReplaySpec) []replayBatch {
+	if replaySpec.ExecutionTree == nil {
+		return nil
+	}
+	treeNodes := replaySpec.ExecutionTree.GetAllNodes()
+
+	var firstRun, lastRun time.Time
+	for _, treeNode := range treeNodes {
+		for _, run := range treeNode.Runs.Values() {
+			runTime := run.(time.Time)
+			if firstRun.IsZero() || runTime.Before(firstRun) {
+				firstRun = runTime
+			}
+			if lastRun.IsZero() || runTime.After(lastRun) {
+				lastRun = runTime
+			}
+		}
+	}
+	if firstRun.IsZero() {
+		return nil
+	}
+
+	var boundaries []time.Time
+	switch {
+	case c.Window > 0:
+		for boundary := firstRun.Add(c.Window); !boundary.After(lastRun); boundary = boundary.Add(c.Window) {
+			boundaries = append(boundaries, boundary)
+		}
+	case c.Size > 0:
+		rootRuns := replaySpec.ExecutionTree.Runs.Values()
+		for idx := c.Size; idx < len(rootRuns); idx += c.Size {
+			if boundary := rootRuns[idx].(time.Time); boundary.After(firstRun) {
+				boundaries = append(boundaries, boundary)
+			}
+		}
+	}
+	boundaries = append(boundaries, lastRun.Add(time.Nanosecond))
+
+	var batches []replayBatch
+	batchStart := firstRun
+	for _, boundary := range boundaries {
+		batch := replayBatch{start: batchStart, end: boundary}
+		batchStart = boundary
+		for _, treeNode := range treeNodes {
+			if len(batch.runs(treeNode)) > 0 {
+				batches = append(batches, batch)
+				break
+			}
+		}
+	}
+	return batches
+}
+
+// clearReplayBatch clears runs of every job of the replay which belong to
+// the batch
+func clearReplayBatch(ctx context.Context, scheduler models.SchedulerUnit, projectSpec models.ProjectSpec,
+	replaySpec models.ReplaySpec, batch replayBatch) error {
+	for _, treeNode := range replaySpec.ExecutionTree.GetAllNodes() {
+		runGroups, err := batch.runGroups(treeNode)
+		if err != nil {
+			return err
+		}
+		for _, runs := range runGroups {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := scheduler.Clear(ctx, projectSpec, treeNode.GetName(), runs[0], runs[len(runs)-1]); err != nil {
+				return errors.Wrapf(err, "error while clearing dag runs for job %s", treeNode.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// isReplayBatchFinished reports if every run of the batch is either
+// succeeded or failed
+func isReplayBatchFinished(ctx context.Context, scheduler models.SchedulerUnit, projectSpec models.ProjectSpec,
+	replaySpec models.ReplaySpec, batch replayBatch) (bool, error) {
+	for _, treeNode := range replaySpec.ExecutionTree.GetAllNodes() {
+		runGroups, err := batch.runGroups(treeNode)
+		if err != nil {
+			return false, err
+		}
+		for _, runs := range runGroups {
+			jobStatuses, err := scheduler.GetJobRunStatus(ctx, projectSpec, treeNode.GetName(), runs[0], runs[len(runs)-1], schedulerBatchSize)
+			if err != nil {
+				return false, err
+			}
+			for _, jobStatus := range jobStatuses {
+				// runs which are not part of the replay aren't waited for
+				if !treeNode.Runs.Contains(jobStatus.ScheduledAt) {
+					continue
+				}
+				if jobStatus.State != models.RunStateSuccess && jobStatus.State != models.RunStateFailed {
+					return false, nil
+				}
+			}
+		}
+	}
+	return true, nil
+}
@@ -22,6 +22,12 @@ var (
 	ErrRequestQueueFull = errors.New("request queue is full")
 	// ErrConflictedJobRun signifies other replay job / dependency run is active or instance already running
 	ErrConflictedJobRun = errors.New("conflicted job run found")
+	// ErrReplayNoFailedRuns signifies none of the runs of a replay of failed runs has failed
+	ErrReplayNoFailedRuns = errors.New("no failed runs found to replay")
+	// ErrReplayNotCancellable signifies the replay has already reached an end state
+	ErrReplayNotCancellable = errors.New("replay is not in a cancellable state")
+	// ReplayMessageCancelled is set on replays cancelled on user request
+	ReplayMessageCancelled = "replay cancelled by user"
 	//ReplayRunTimeout signifies type of replay failure caused by timeout
 	ReplayRunTimeout = "long running replay timeout"
 	// TimestampLogFormat format of a timestamp will be used in logs
@@ -30,6 +36,10 @@ var (
 	schedulerBatchSize = 100
 	//replayListWindow window interval to fetch recent replays
 	replayListWindow = -3 * 30 * 24 * time.Hour
+	// ReplayStatusToRecover replays which are resumed when server starts
+	ReplayStatusToRecover = []string{models.ReplayStatusAccepted, models.ReplayStatusInProgress}
+	// ReplayStatusCancellable replays which haven't reached an end state yet
+	ReplayStatusCancellable = []string{models.ReplayStatusAccepted, models.ReplayStatusInProgress, models.ReplayStatusReplayed}
 )
 
 const (
@@ -40,6 +50,9 @@ type ReplayManagerConfig struct {
 	NumWorkers    int
 	WorkerTimeout time.Duration
 	RunTimeout    time.Duration
+
+	// BatchConfig is the batching used by workers and syncer to clear runs
+	BatchConfig ReplayBatchConfig
 }
 
 type ReplayWorkerFactory interface {
@@ -54,6 +67,15 @@ type ReplayValidator interface {
 	Validate(context.Context, store.ReplaySpecRepository, models.ReplayRequest, *tree.TreeNode) error
 }
 
+// ReplayLocker guards a replay from being processed by multiple server
+// replicas at once
+type ReplayLocker interface {
+	// TryLock acquires lock of the replay without waiting, false is returned
+	// if the lock is held by some other replica
+	TryLock(ctx context.Context, replayID uuid.UUID) (bool, error)
+	Unlock(ctx context.Context, replayID uuid.UUID) error
+}
+
 // Manager for replaying operation(s).
 // Offers an asynchronous interface to pipeline, with a fixed size request queue
 // Each replay request is handled by a replay worker and the number of parallel replay workers
@@ -74,9 +96,19 @@ type Manager struct {
 	replayValidator     ReplayValidator
 	replaySyncer        ReplaySyncer
 	syncerScheduler     *cron.Cron
+	projectRepoFac      ProjectRepoFactory
+	replayLocker        ReplayLocker
 	l                   log.Logger
 
+	// stops queueing of recovered replays on close
+	recoveryWg   sync.WaitGroup
+	stopRecovery chan struct{}
+
 	workerCapacity int32
+
+	// cancel functions of replays being processed by workers
+	inFlight   map[uuid.UUID]context.CancelFunc
+	inFlightMu sync.Mutex
 }
 
 // Replay a request asynchronously, returns a replay id that can
@@ -88,6 +120,14 @@ func (m *Manager) Replay(ctx context.Context, reqInput models.ReplayRequest) (st
 	if err != nil {
 		return "", err
 	}
+	if reqInput.FailedRunsOnly {
+		if replayTree, err = pruneToFailedRuns(ctx, reqInput.Project, replayTree, reqInput.FailedRunsDownstream, m.GetRunStatus); err != nil {
+			return "", err
+		}
+		if replayTree.Runs.Size() == 0 && len(replayTree.Dependents) == 0 {
+			return "", ErrReplayNoFailedRuns
+		}
+	}
 	if err := m.replayValidator.Validate(ctx, replaySpecRepo, reqInput, replayTree); err != nil {
 		return "", err
 	}
@@ -131,16 +171,199 @@ func (m *Manager) spawnServiceWorker(worker ReplayWorker) {
 		atomic.AddInt32(&m.workerCapacity, -1)
 
 		m.l.Info("worker picked up the request", "request id", reqInput.ID)
-		ctx, cancelCtx := context.WithTimeout(context.Background(), m.config.WorkerTimeout)
-		if err := worker.Process(ctx, reqInput); err != nil {
-			m.l.Error("worker failed to process", "error", err)
-		}
-		cancelCtx()
+		m.processRequest(worker, reqInput)
 
 		atomic.AddInt32(&m.workerCapacity, 1)
 	}
 }
 
+func (m *Manager) processRequest(worker ReplayWorker, reqInput models.ReplayRequest) {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), m.config.WorkerTimeout)
+	defer cancelCtx()
+
+	if m.replayLocker != nil {
+		locked, err := m.replayLocker.TryLock(ctx, reqInput.ID)
+		if err != nil {
+			m.l.Error("failed to acquire replay lock", "request id", reqInput.ID, "error", err)
+			return
+		}
+		if !locked {
+			m.l.Info("replay is being processed by another server", "request id", reqInput.ID)
+			return
+		}
+		defer func() {
+			if err := m.replayLocker.Unlock(context.Background(), reqInput.ID); err != nil {
+				m.l.Error("failed to release replay lock", "request id", reqInput.ID, "error", err)
+			}
+		}()
+	}
+
+	// replay might have been cancelled while queued or finished by another
+	// server before the lock got acquired
+	replaySpec, err := m.replaySpecRepoFac.New().GetByID(ctx, reqInput.ID)
+	if err != nil {
+		m.l.Error("failed to fetch replay", "request id", reqInput.ID, "error", err)
+		return
+	}
+	if replaySpec.Status != models.ReplayStatusAccepted && replaySpec.Status != models.ReplayStatusInProgress {
+		m.l.Info("replay is already processed", "request id", reqInput.ID, "status", replaySpec.Status)
+		return
+	}
+
+	m.trackInFlight(reqInput.ID, cancelCtx)
+	defer m.untrackInFlight(reqInput.ID)
+	if err := worker.Process(ctx, reqInput); err != nil {
+		m.l.Error("worker failed to process", "error", err)
+	}
+}
+
+// recoverReplays queues replays which were accepted or in progress when the
+// server stopped, replays still being processed by other replicas are skipped
+// by workers as their lock is held
+func (m *Manager) recoverReplays() {
+	defer m.recoveryWg.Done()
+	ctx, cancelCtx := context.WithTimeout(context.Background(), m.config.WorkerTimeout)
+	defer cancelCtx()
+
+	projectSpecs, err := m.projectRepoFac.New().GetAll(ctx)
+	if err != nil {
+		m.l.Error("failed to fetch projects for replay recovery", "error", err)
+		return
+	}
+	replaySpecRepo := m.replaySpecRepoFac.New()
+	for _, projectSpec := range projectSpecs {
+		replaySpecs, err := replaySpecRepo.GetByProjectIDAndStatus(ctx, projectSpec.ID, ReplayStatusToRecover)
+		if err != nil {
+			if err == store.ErrResourceNotFound {
+				continue
+			}
+			m.l.Error("failed to fetch replays for recovery", "project", projectSpec.Name, "error", err)
+			continue
+		}
+
+		for _, replaySpec := range replaySpecs {
+			reqInput := models.ReplayRequest{
+				ID:      replaySpec.ID,
+				Job:     replaySpec.Job,
+				Start:   replaySpec.StartDate,
+				End:     replaySpec.EndDate,
+				Project: projectSpec,
+			}
+			select {
+			case m.requestQ <- reqInput:
+				m.l.Info("recovered replay", "request id", replaySpec.ID, "status", replaySpec.Status)
+			case <-m.stopRecovery:
+				return
+			}
+		}
+	}
+}
+
+func (m *Manager) trackInFlight(replayID uuid.UUID, cancel context.CancelFunc) {
+	m.inFlightMu.Lock()
+	defer m.inFlightMu.Unlock()
+	m.inFlight[replayID] = cancel
+}
+
+func (m *Manager) untrackInFlight(replayID uuid.UUID) {
+	m.inFlightMu.Lock()
+	defer m.inFlightMu.Unlock()
+	delete(m.inFlight, replayID)
+}
+
+// Cancel stops the worker if it is still clearing runs of the replay and marks
+// the replay cancelled, runs already cleared by the replay are marked failed
+// in scheduler if failRuns is set
+func (m *Manager) Cancel(ctx context.Context, projectSpec models.ProjectSpec, replayID uuid.UUID, failRuns bool) error {
+	replaySpecRepo := m.replaySpecRepoFac.New()
+	replaySpec, err := replaySpecRepo.GetByID(ctx, replayID)
+	if err != nil {
+		return err
+	}
+	if err := m.verifyReplayProject(ctx, replaySpecRepo, projectSpec, replaySpec); err != nil {
+		return err
+	}
+	switch replaySpec.Status {
+	case models.ReplayStatusFailed, models.ReplayStatusSuccess, models.ReplayStatusCancelled:
+		return errors.Wrapf(ErrReplayNotCancellable, "replay %s is %s", replayID, replaySpec.Status)
+	}
+
+	// replay could have finished since it was fetched
+	cancelled, err := replaySpecRepo.UpdateStatusFrom(ctx, replayID, ReplayStatusCancellable, models.ReplayStatusCancelled,
+		models.ReplayMessage{
+			Type:    models.ReplayStatusCancelled,
+			Message: ReplayMessageCancelled,
+		})
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return errors.Wrapf(ErrReplayNotCancellable, "replay %s is already finished", replayID)
+	}
+
+	m.inFlightMu.Lock()
+	if cancel, ok := m.inFlight[replayID]; ok {
+		cancel()
+	}
+	m.inFlightMu.Unlock()
+	if !failRuns {
+		return nil
+	}
+
+	cancellingScheduler, ok := m.scheduler.(models.RunCancellingScheduler)
+	if !ok {
+		return errors.Errorf("scheduler %s doesn't support cancelling runs", m.scheduler.GetName())
+	}
+	for _, batch := range m.clearedBatches(replaySpec) {
+		for _, treeNode := range replaySpec.ExecutionTree.GetAllNodes() {
+			runGroups, err := batch.runGroups(treeNode)
+			if err != nil {
+				return err
+			}
+			for _, runs := range runGroups {
+				if err := cancellingScheduler.CancelRuns(ctx, projectSpec, treeNode.GetName(), runs[0], runs[len(runs)-1]); err != nil {
+					return errors.Wrapf(err, "error while cancelling dag runs for job %s", treeNode.GetName())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clearedBatches returns batches of the replay whose runs are already
+// cleared in scheduler, the first batch is cleared by the worker before the
+// progress is stored
+func (m *Manager) clearedBatches(replaySpec models.ReplaySpec) []replayBatch {
+	if replaySpec.Status == models.ReplayStatusAccepted {
+		return nil
+	}
+	batches := m.config.BatchConfig.batches(replaySpec)
+	clearedCount := 1
+	if replaySpec.Progress.TotalBatches > 0 {
+		clearedCount = replaySpec.Progress.ClearedBatches
+	}
+	if clearedCount > len(batches) {
+		clearedCount = len(batches)
+	}
+	return batches[:clearedCount]
+}
+
+// verifyReplayProject returns not found if the replay is of a job of some
+// other project
+func (m *Manager) verifyReplayProject(ctx context.Context, replaySpecRepo store.ReplaySpecRepository,
+	projectSpec models.ProjectSpec, replaySpec models.ReplaySpec) error {
+	projectReplays, err := replaySpecRepo.GetByProjectIDAndStatus(ctx, projectSpec.ID, []string{replaySpec.Status})
+	if err != nil && err != store.ErrResourceNotFound {
+		return err
+	}
+	for _, projectReplay := range projectReplays {
+		if projectReplay.ID == replaySpec.ID {
+			return nil
+		}
+	}
+	return errors.Wrapf(store.ErrResourceNotFound, "replay %s of project %s", replaySpec.ID, projectSpec.Name)
+}
+
 // SchedulerSyncer to sync for latest replay status
 func (m *Manager) SchedulerSyncer() {
 	m.l.Debug("start synchronizing replays...")
@@ -185,6 +408,10 @@ func (m *Manager) GetRunStatus(ctx context.Context, projectSpec models.ProjectSp
 
 //Close stops consuming any new request
 func (m *Manager) Close() error {
+	// stop queueing recovered replays before closing the queue
+	close(m.stopRecovery)
+	m.recoveryWg.Wait()
+
 	if m.requestQ != nil {
 		//stop accepting any more requests
 		close(m.requestQ)
@@ -222,11 +449,17 @@ func (m *Manager) Init() {
 		}
 		time.Sleep(time.Millisecond * 50)
 	}
+
+	if m.projectRepoFac != nil && m.config.NumWorkers > 0 {
+		m.recoveryWg.Add(1)
+		go m.recoverReplays()
+	}
 }
 
 // NewManager constructs a new instance of Manager
 func NewManager(l log.Logger, workerFact ReplayWorkerFactory, replaySpecRepoFac ReplaySpecRepoFactory, uuidProvider utils.UUIDProvider,
-	config ReplayManagerConfig, scheduler models.SchedulerUnit, validator ReplayValidator, syncer ReplaySyncer) *Manager {
+	config ReplayManagerConfig, scheduler models.SchedulerUnit, validator ReplayValidator, syncer ReplaySyncer,
+	projectRepoFac ProjectRepoFactory, locker ReplayLocker) *Manager {
 	mgr := &Manager{
 		l:                   l,
 		replayWorkerFactory: workerFact,
@@ -237,7 +470,11 @@ func NewManager(l log.Logger, workerFact ReplayWorkerFactory, replaySpecRepoFac
 		scheduler:           scheduler,
 		replayValidator:     validator,
 		replaySyncer:        syncer,
+		projectRepoFac:      projectRepoFac,
+		replayLocker:        locker,
+		stopRecovery:        make(chan struct{}),
 		workerCapacity:      0,
+		inFlight:            make(map[uuid.UUID]context.CancelFunc),
 		syncerScheduler: cron.New(cron.WithChain(
 			cron.SkipIfStillRunning(cron.DefaultLogger),
 		)),
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/odpf/optimus/core/tree"
 	"github.com/odpf/optimus/store"
 	"github.com/pkg/errors"
 
@@ -31,7 +32,7 @@ func TestReplayManager(t *testing.T) {
 		replayWorkerFact.On("New").Return(worker)
 		defer replayWorkerFact.AssertExpectations(t)
 
-		manager := job.NewManager(log, replayWorkerFact, nil, nil, replayManagerConfig, nil, nil, nil)
+		manager := job.NewManager(log, replayWorkerFact, nil, nil, replayManagerConfig, nil, nil, nil, nil, nil)
 		worker.Close()
 
 		err := manager.Close()
@@ -95,7 +96,7 @@ func TestReplayManager(t *testing.T) {
 			replayWorkerFact.On("New").Return(worker)
 			defer replayWorkerFact.AssertExpectations(t)
 
-			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil)
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil, nil, nil)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errMessage)
@@ -104,6 +105,36 @@ func TestReplayManager(t *testing.T) {
 			err = replayManager.Close()
 			assert.Nil(t, err)
 		})
+		t.Run("should throw an error if none of the runs has failed when replaying only failed runs", func(t *testing.T) {
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			failedRunsRequest := replayRequest
+			failedRunsRequest.FailedRunsOnly = true
+			firstRun := time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC)
+			lastRun := time.Date(2020, time.Month(8), 26, 2, 0, 0, 0, time.UTC)
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, failedRunsRequest.Project, jobSpec.Name, firstRun, lastRun.AddDate(0, 0, 1).Add(-time.Second), 100).
+				Return([]models.JobStatus{{ScheduledAt: firstRun, State: models.RunStateSuccess}}, nil)
+
+			worker := mock.NewReplayWorker()
+			replayWorkerFact := new(mock.ReplayWorkerFactory)
+			replayWorkerFact.On("New").Return(worker)
+			defer replayWorkerFact.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, scheduler, nil, nil, nil, nil)
+			_, err := replayManager.Replay(ctx, failedRunsRequest)
+			assert.Equal(t, job.ErrReplayNoFailedRuns, err)
+
+			worker.Close()
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
 		t.Run("should throw an error if replay repo throws error", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
@@ -136,7 +167,7 @@ func TestReplayManager(t *testing.T) {
 			replayWorkerFact.On("New").Return(worker)
 			defer replayWorkerFact.AssertExpectations(t)
 
-			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil)
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil, nil, nil)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errMessage)
@@ -162,7 +193,7 @@ func TestReplayManager(t *testing.T) {
 			replayWorkerFact.On("New").Return(worker)
 			defer replayWorkerFact.AssertExpectations(t)
 
-			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, replayValidator, nil)
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, replayValidator, nil, nil, nil)
 
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, err, job.ErrConflictedJobRun)
@@ -196,6 +227,7 @@ func TestReplayManager(t *testing.T) {
 				Status:    models.ReplayStatusAccepted,
 			}
 			replayRepository.On("Insert", ctx, toInsertReplaySpec).Return(nil)
+			replayRepository.On("GetByID", mocklib.Anything, objUUID).Return(*toInsertReplaySpec, nil)
 
 			worker := mock.NewReplayWorker()
 			replayRequestToProcess := replayRequest
@@ -211,7 +243,7 @@ func TestReplayManager(t *testing.T) {
 
 				NumWorkers:    1,
 				WorkerTimeout: time.Second * 5,
-			}, nil, replayValidator, nil)
+			}, nil, replayValidator, nil, nil, nil)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Nil(t, err)
 
@@ -276,7 +308,7 @@ func TestReplayManager(t *testing.T) {
 		//	replayWorkerFact.On("New").Times(replayManagerConfig.NumWorkers)
 		//	defer replayWorkerFact.AssertExpectations(t)
 		//
-		//	replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil)
+		//	replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil, replayValidator, nil, nil, nil)
 		//
 		//	_, err := replayManager.Replay(ctx, replayRequest)
 		//	assert.Nil(t, err)
@@ -299,6 +331,191 @@ func TestReplayManager(t *testing.T) {
 		//	assert.Nil(t, err)
 		//})
 	})
+	t.Run("Recover", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "project-name",
+		}
+		replaySpec := models.ReplaySpec{
+			ID:        uuid.Must(uuid.NewRandom()),
+			Job:       models.JobSpec{Name: "sample-job"},
+			StartDate: time.Date(2020, time.Month(8), 20, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2020, time.Month(8), 22, 0, 0, 0, 0, time.UTC),
+			Status:    models.ReplayStatusAccepted,
+		}
+		replayManagerConfig := job.ReplayManagerConfig{
+			NumWorkers:    1,
+			WorkerTimeout: time.Second * 5,
+		}
+
+		t.Run("should resume replays accepted before server restart", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", mocklib.Anything).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepository)
+			defer projectRepoFac.AssertExpectations(t)
+
+			replayRepository := new(mock.ReplayRepository)
+			replayRepository.On("GetByProjectIDAndStatus", mocklib.Anything, projectSpec.ID, job.ReplayStatusToRecover).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("GetByID", mocklib.Anything, replaySpec.ID).Return(replaySpec, nil)
+			defer replayRepository.AssertExpectations(t)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			defer replaySpecRepoFac.AssertExpectations(t)
+
+			replayLocker := new(mock.ReplayLocker)
+			replayLocker.On("TryLock", mocklib.Anything, replaySpec.ID).Return(true, nil)
+			replayLocker.On("Unlock", mocklib.Anything, replaySpec.ID).Return(nil)
+			defer replayLocker.AssertExpectations(t)
+
+			processed := make(chan bool, 1)
+			worker := mock.NewReplayWorker()
+			worker.On("Process", mocklib.Anything, models.ReplayRequest{
+				ID:      replaySpec.ID,
+				Job:     replaySpec.Job,
+				Start:   replaySpec.StartDate,
+				End:     replaySpec.EndDate,
+				Project: projectSpec,
+			}).Run(func(args mocklib.Arguments) { processed <- true }).Return(nil)
+			defer worker.AssertExpectations(t)
+
+			replayWorkerFact := new(mock.ReplayWorkerFactory)
+			replayWorkerFact.On("New").Return(worker)
+			defer replayWorkerFact.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, nil, nil,
+				projectRepoFac, replayLocker)
+			<-processed
+
+			worker.Close()
+			err := replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should skip replays locked by another server", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", mocklib.Anything).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepository)
+			defer projectRepoFac.AssertExpectations(t)
+
+			replayRepository := new(mock.ReplayRepository)
+			replayRepository.On("GetByProjectIDAndStatus", mocklib.Anything, projectSpec.ID, job.ReplayStatusToRecover).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			defer replayRepository.AssertExpectations(t)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			defer replaySpecRepoFac.AssertExpectations(t)
+
+			lockTried := make(chan bool, 1)
+			replayLocker := new(mock.ReplayLocker)
+			replayLocker.On("TryLock", mocklib.Anything, replaySpec.ID).
+				Run(func(args mocklib.Arguments) { lockTried <- true }).Return(false, nil)
+			defer replayLocker.AssertExpectations(t)
+
+			worker := mock.NewReplayWorker()
+			defer worker.AssertExpectations(t)
+
+			replayWorkerFact := new(mock.ReplayWorkerFactory)
+			replayWorkerFact.On("New").Return(worker)
+			defer replayWorkerFact.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, nil, nil,
+				projectRepoFac, replayLocker)
+			<-lockTried
+
+			worker.Close()
+			err := replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should skip replays already finished by another server", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", mocklib.Anything).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepository)
+			defer projectRepoFac.AssertExpectations(t)
+
+			replayedSpec := replaySpec
+			replayedSpec.Status = models.ReplayStatusReplayed
+			replayRepository := new(mock.ReplayRepository)
+			replayRepository.On("GetByProjectIDAndStatus", mocklib.Anything, projectSpec.ID, job.ReplayStatusToRecover).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("GetByID", mocklib.Anything, replaySpec.ID).Return(replayedSpec, nil)
+			defer replayRepository.AssertExpectations(t)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			defer replaySpecRepoFac.AssertExpectations(t)
+
+			unlocked := make(chan bool, 1)
+			replayLocker := new(mock.ReplayLocker)
+			replayLocker.On("TryLock", mocklib.Anything, replaySpec.ID).Return(true, nil)
+			replayLocker.On("Unlock", mocklib.Anything, replaySpec.ID).
+				Run(func(args mocklib.Arguments) { unlocked <- true }).Return(nil)
+			defer replayLocker.AssertExpectations(t)
+
+			worker := mock.NewReplayWorker()
+			defer worker.AssertExpectations(t)
+
+			replayWorkerFact := new(mock.ReplayWorkerFactory)
+			replayWorkerFact.On("New").Return(worker)
+			defer replayWorkerFact.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, nil, nil,
+				projectRepoFac, replayLocker)
+			<-unlocked
+
+			worker.Close()
+			err := replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should skip replays cancelled before being processed without a locker", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", mocklib.Anything).Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepository)
+			defer projectRepoFac.AssertExpectations(t)
+
+			cancelledSpec := replaySpec
+			cancelledSpec.Status = models.ReplayStatusCancelled
+			fetched := make(chan bool, 1)
+			replayRepository := new(mock.ReplayRepository)
+			replayRepository.On("GetByProjectIDAndStatus", mocklib.Anything, projectSpec.ID, job.ReplayStatusToRecover).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("GetByID", mocklib.Anything, replaySpec.ID).
+				Run(func(args mocklib.Arguments) { fetched <- true }).Return(cancelledSpec, nil)
+			defer replayRepository.AssertExpectations(t)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			defer replaySpecRepoFac.AssertExpectations(t)
+
+			worker := mock.NewReplayWorker()
+			defer worker.AssertExpectations(t)
+
+			replayWorkerFact := new(mock.ReplayWorkerFactory)
+			replayWorkerFact.On("New").Return(worker)
+			defer replayWorkerFact.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, replayWorkerFact, replaySpecRepoFac, nil, replayManagerConfig, nil, nil, nil,
+				projectRepoFac, nil)
+			<-fetched
+
+			worker.Close()
+			err := replayManager.Close()
+			assert.Nil(t, err)
+		})
+	})
 	t.Run("GetReplay", func(t *testing.T) {
 		t.Run("should return replay given a valid UUID", func(t *testing.T) {
 			replayUUID := uuid.Must(uuid.NewRandom())
@@ -321,7 +538,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayResult, err := replayManager.GetReplay(ctx, replayUUID)
 
 			assert.Nil(t, err)
@@ -341,7 +558,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayResult, err := replayManager.GetReplay(ctx, replayUUID)
 
 			assert.Equal(t, err, store.ErrResourceNotFound)
@@ -351,6 +568,202 @@ func TestReplayManager(t *testing.T) {
 			assert.Nil(t, err)
 		})
 	})
+	t.Run("Cancel", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "project-name",
+		}
+		replayUUID := uuid.Must(uuid.NewRandom())
+		firstRun := time.Date(2020, time.Month(8), 20, 2, 0, 0, 0, time.UTC)
+		lastRun := time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC)
+		sampleJob := models.JobSpec{
+			Name:     "sample-job",
+			Schedule: models.JobSpecSchedule{Interval: "0 2 * * *"},
+		}
+		executionTree := tree.NewTreeNode(sampleJob)
+		executionTree.Runs.Add(firstRun)
+		executionTree.Runs.Add(firstRun.AddDate(0, 0, 1))
+		executionTree.Runs.Add(lastRun)
+		cancelledMessage := models.ReplayMessage{
+			Type:    models.ReplayStatusCancelled,
+			Message: job.ReplayMessageCancelled,
+		}
+
+		t.Run("should mark replay cancelled and fail its unfinished runs", func(t *testing.T) {
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusReplayed,
+				ExecutionTree: executionTree,
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusReplayed}).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, replayUUID, job.ReplayStatusCancellable, models.ReplayStatusCancelled, cancelledMessage).
+				Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("CancelRuns", ctx, projectSpec, "sample-job", firstRun, lastRun).Return(nil)
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, scheduler, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, true)
+			assert.Nil(t, err)
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should fail only the runs of batches already cleared", func(t *testing.T) {
+			gappedTree := tree.NewTreeNode(sampleJob)
+			gappedTree.Runs.Add(firstRun)
+			gappedTree.Runs.Add(lastRun)
+			gappedTree.Runs.Add(lastRun.AddDate(0, 0, 1))
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusReplayed,
+				ExecutionTree: gappedTree,
+				Progress:      models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2},
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusReplayed}).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, replayUUID, job.ReplayStatusCancellable, models.ReplayStatusCancelled, cancelledMessage).
+				Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("CancelRuns", ctx, projectSpec, "sample-job", firstRun, firstRun).Return(nil).Once()
+			scheduler.On("CancelRuns", ctx, projectSpec, "sample-job", lastRun, lastRun).Return(nil).Once()
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{
+				BatchConfig: job.ReplayBatchConfig{Size: 2},
+			}, scheduler, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, true)
+			assert.Nil(t, err)
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should only mark replay cancelled if runs are not to be failed", func(t *testing.T) {
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusInProgress,
+				ExecutionTree: executionTree,
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusInProgress}).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, replayUUID, job.ReplayStatusCancellable, models.ReplayStatusCancelled, cancelledMessage).
+				Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, scheduler, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, false)
+			assert.Nil(t, err)
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if replay is already finished", func(t *testing.T) {
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusSuccess,
+				ExecutionTree: executionTree,
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusSuccess}).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, true)
+			assert.True(t, errors.Is(err, job.ErrReplayNotCancellable))
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if replay finishes before getting cancelled", func(t *testing.T) {
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusReplayed,
+				ExecutionTree: executionTree,
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusReplayed}).
+				Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, replayUUID, job.ReplayStatusCancellable, models.ReplayStatusCancelled, cancelledMessage).
+				Return(false, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, scheduler, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, true)
+			assert.True(t, errors.Is(err, job.ErrReplayNotCancellable))
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+		t.Run("should return not found if replay belongs to another project", func(t *testing.T) {
+			replaySpec := models.ReplaySpec{
+				ID:            replayUUID,
+				Status:        models.ReplayStatusInProgress,
+				ExecutionTree: executionTree,
+			}
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByID", ctx, replayUUID).Return(replaySpec, nil)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpec.ID, []string{models.ReplayStatusInProgress}).
+				Return([]models.ReplaySpec{}, store.ErrResourceNotFound)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
+			err := replayManager.Cancel(ctx, projectSpec, replayUUID, false)
+			assert.True(t, errors.Is(err, store.ErrResourceNotFound))
+
+			err = replayManager.Close()
+			assert.Nil(t, err)
+		})
+	})
 	t.Run("GetReplayList", func(t *testing.T) {
 		t.Run("should return replay list given a valid project UUID", func(t *testing.T) {
 			projectUUID := uuid.Must(uuid.NewRandom())
@@ -378,7 +791,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayListResult, err := replayManager.GetReplayList(ctx, projectUUID)
 
 			assert.Nil(t, err)
@@ -421,7 +834,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayListResult, err := replayManager.GetReplayList(ctx, projectUUID)
 
 			expectedReplaySpecs := []models.ReplaySpec{replaySpecs[0]}
@@ -442,7 +855,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayResult, err := replayManager.GetReplayList(ctx, projectUUID)
 
 			assert.Nil(t, err)
@@ -463,7 +876,7 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil)
+			replayManager := job.NewManager(log, nil, replaySpecRepoFac, nil, job.ReplayManagerConfig{}, nil, nil, nil, nil, nil)
 			replayResult, err := replayManager.GetReplayList(ctx, projectUUID)
 
 			assert.Equal(t, errorMsg, err.Error())
@@ -508,7 +921,7 @@ func TestReplayManager(t *testing.T) {
 			batchEndDate := endDate.AddDate(0, 0, 1).Add(time.Second * -1)
 			scheduler.On("GetJobRunStatus", ctx, projectSpec, jobSpec.Name, startDate, batchEndDate, 100).Return(jobStatusList, nil)
 
-			replayManager := job.NewManager(log, nil, nil, nil, job.ReplayManagerConfig{}, scheduler, nil, nil)
+			replayManager := job.NewManager(log, nil, nil, nil, job.ReplayManagerConfig{}, scheduler, nil, nil, nil, nil)
 			jobStatusMap, err := replayManager.GetRunStatus(context.TODO(), projectSpec, replaySpec.StartDate, replaySpec.EndDate, jobSpec.Name)
 
 			assert.Nil(t, err)
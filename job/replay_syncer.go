@@ -28,17 +28,21 @@ type Syncer struct {
 	replaySpecFactory  ReplaySpecRepoFactory
 	projectRepoFactory ProjectRepoFactory
 	scheduler          models.SchedulerUnit
+	batchConfig        ReplayBatchConfig
+	replayLocker       ReplayLocker
 	Now                func() time.Time
 	l                  log.Logger
 }
 
 func NewReplaySyncer(log log.Logger, replaySpecFactory ReplaySpecRepoFactory, projectRepoFactory ProjectRepoFactory, scheduler models.SchedulerUnit,
-	timeFn func() time.Time) *Syncer {
+	batchConfig ReplayBatchConfig, timeFn func() time.Time, locker ReplayLocker) *Syncer {
 	return &Syncer{
 		l:                  log,
 		replaySpecFactory:  replaySpecFactory,
 		projectRepoFactory: projectRepoFactory,
 		scheduler:          scheduler,
+		batchConfig:        batchConfig,
+		replayLocker:       locker,
 		Now:                timeFn,
 	}
 }
@@ -94,6 +98,10 @@ func (s Syncer) syncTimedOutReplay(ctx context.Context, replaySpecRepo store.Rep
 }
 
 func (s Syncer) syncRunningReplay(ctx context.Context, projectSpec models.ProjectSpec, replaySpec models.ReplaySpec, replaySpecRepo store.ReplaySpecRepository) error {
+	if !replaySpec.Progress.IsCleared() {
+		return s.syncReplayBatches(ctx, projectSpec, replaySpec, replaySpecRepo)
+	}
+
 	stateSummary, err := s.checkInstanceState(ctx, projectSpec, replaySpec)
 	if err != nil {
 		return err
@@ -102,6 +110,79 @@ func (s Syncer) syncRunningReplay(ctx context.Context, projectSpec models.Projec
 	return updateCompletedReplays(ctx, s.l, stateSummary, replaySpecRepo, replaySpec.ID)
 }
 
+// syncReplayBatches clears the next batch of the replay once runs of the
+// previous batch are finished, the replay is locked so the same batch isn't
+// cleared by multiple servers
+func (s Syncer) syncReplayBatches(ctx context.Context, projectSpec models.ProjectSpec, replaySpec models.ReplaySpec, replaySpecRepo store.ReplaySpecRepository) error {
+	if s.replayLocker != nil {
+		locked, err := s.replayLocker.TryLock(ctx, replaySpec.ID)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			s.l.Debug("replay is being processed by another server", "replay id", replaySpec.ID.String())
+			return nil
+		}
+		defer func() {
+			if err := s.replayLocker.Unlock(context.Background(), replaySpec.ID); err != nil {
+				s.l.Error("failed to release replay lock", "replay id", replaySpec.ID.String(), "error", err)
+			}
+		}()
+
+		// progress might have been updated by another server since the
+		// replay got fetched
+		if replaySpec, err = replaySpecRepo.GetByID(ctx, replaySpec.ID); err != nil {
+			return err
+		}
+		if replaySpec.Status != models.ReplayStatusReplayed || replaySpec.Progress.IsCleared() {
+			return nil
+		}
+	}
+
+	batches := s.batchConfig.batches(replaySpec)
+	progress := replaySpec.Progress
+	if progress.ClearedBatches >= len(batches) {
+		// batches got fewer due to change in batch config
+		return replaySpecRepo.UpdateProgress(ctx, replaySpec.ID, models.ReplayProgress{
+			ClearedBatches: len(batches),
+			TotalBatches:   len(batches),
+		})
+	}
+
+	if progress.ClearedBatches > 0 {
+		finished, err := isReplayBatchFinished(ctx, s.scheduler, projectSpec, replaySpec, batches[progress.ClearedBatches-1])
+		if err != nil {
+			return err
+		}
+		if !finished {
+			return nil
+		}
+	}
+
+	if err := clearReplayBatch(ctx, s.scheduler, projectSpec, replaySpec, batches[progress.ClearedBatches]); err != nil {
+		s.l.Warn("error while clearing replay batch", "replay id", replaySpec.ID.String(), "error", err.Error())
+		if updateStatusErr := replaySpecRepo.UpdateStatus(ctx, replaySpec.ID, models.ReplayStatusFailed, models.ReplayMessage{
+			Type:    AirflowClearDagRunFailed,
+			Message: err.Error(),
+		}); updateStatusErr != nil {
+			s.l.Error("marking replay as failed error", "status error", updateStatusErr)
+			return updateStatusErr
+		}
+		return nil
+	}
+
+	if err := replaySpecRepo.UpdateProgress(ctx, replaySpec.ID, models.ReplayProgress{
+		ClearedBatches: progress.ClearedBatches + 1,
+		TotalBatches:   len(batches),
+	}); err != nil {
+		return err
+	}
+	s.l.Info("cleared replay batch", "replay id", replaySpec.ID.String(), "batch", progress.ClearedBatches+1, "total batches", len(batches))
+	return nil
+}
+
+// checkInstanceState summarises states of the runs of the replay, runs
+// which are not yet succeeded or failed are counted as running
 func (s Syncer) checkInstanceState(ctx context.Context, projectSpec models.ProjectSpec, replaySpec models.ReplaySpec) (map[models.JobRunState]int, error) {
 	stateSummary := make(map[models.JobRunState]int)
 	stateSummary[models.RunStateRunning] = 0
@@ -109,13 +190,26 @@ func (s Syncer) checkInstanceState(ctx context.Context, projectSpec models.Proje
 	stateSummary[models.RunStateSuccess] = 0
 
 	for _, node := range replaySpec.ExecutionTree.GetAllNodes() {
+		// jobs kept in the tree only to reach their downstream have no run
+		if node.Runs.Size() == 0 {
+			continue
+		}
 		batchEndDate := replaySpec.EndDate.AddDate(0, 0, 1).Add(time.Second * -1)
 		jobStatusAllRuns, err := s.scheduler.GetJobRunStatus(ctx, projectSpec, node.Data.(models.JobSpec).Name, replaySpec.StartDate, batchEndDate, schedulerBatchSize)
 		if err != nil {
 			return nil, err
 		}
 		for _, jobStatus := range jobStatusAllRuns {
-			stateSummary[jobStatus.State]++
+			// runs outside of the replay aren't affected by it
+			if !node.Runs.Contains(jobStatus.ScheduledAt) {
+				continue
+			}
+			switch jobStatus.State {
+			case models.RunStateSuccess, models.RunStateFailed:
+				stateSummary[jobStatus.State]++
+			default:
+				stateSummary[models.RunStateRunning]++
+			}
 		}
 	}
 	return stateSummary, nil
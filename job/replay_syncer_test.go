@@ -96,7 +96,7 @@ func TestReplaySyncer(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Nil(t, err)
@@ -119,7 +119,7 @@ func TestReplaySyncer(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Equal(t, errorMsg, err.Error())
@@ -162,7 +162,7 @@ func TestReplaySyncer(t *testing.T) {
 			}
 			replayRepository.On("UpdateStatus", ctx, activeReplayUUID, models.ReplayStatusSuccess, successReplayMessage).Return(nil)
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Nil(t, err)
@@ -205,7 +205,7 @@ func TestReplaySyncer(t *testing.T) {
 			}
 			replayRepository.On("UpdateStatus", ctx, activeReplayUUID, models.ReplayStatusFailed, failedReplayMessage).Return(nil)
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Nil(t, err)
@@ -242,7 +242,275 @@ func TestReplaySyncer(t *testing.T) {
 			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
 			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec2].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should not update replay status if instances are yet to run", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return(activeReplaySpec, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			jobStatus := []models.JobStatus{
+				{
+					ScheduledAt: time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateFailed,
+				},
+				{
+					ScheduledAt: time.Date(2020, time.Month(8), 23, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStatePending,
+				},
+			}
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec2].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should ignore state of runs which are not part of the replay", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return(activeReplaySpec, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			jobStatus := []models.JobStatus{
+				{
+					ScheduledAt: time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateSuccess,
+				},
+				{
+					ScheduledAt: time.Date(2020, time.Month(8), 23, 2, 0, 0, 0, time.UTC),
+					State:       models.RunStateSuccess,
+				},
+				{
+					ScheduledAt: time.Date(2020, time.Month(8), 23, 14, 0, 0, 0, time.UTC),
+					State:       models.RunStateFailed,
+				},
+			}
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec2].Name, startDate, batchEndDate, reqBatchSize).Return(jobStatus, nil).Once()
+
+			successReplayMessage := models.ReplayMessage{
+				Type:    models.ReplayStatusSuccess,
+				Message: job.ReplayMessageSuccess,
+			}
+			replayRepository.On("UpdateStatus", ctx, activeReplayUUID, models.ReplayStatusSuccess, successReplayMessage).Return(nil)
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should clear next batch of replay once runs of previous batch are finished", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			batchedReplaySpec := activeReplaySpec[0]
+			batchedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2}
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return([]models.ReplaySpec{batchedReplaySpec}, nil)
+			replayRepository.On("UpdateProgress", ctx, activeReplayUUID, models.ReplayProgress{ClearedBatches: 2, TotalBatches: 2}).Return(nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			firstRun := time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC)
+			secondRun := time.Date(2020, time.Month(8), 23, 2, 0, 0, 0, time.UTC)
+			jobStatus := []models.JobStatus{
+				{
+					ScheduledAt: firstRun,
+					State:       models.RunStateSuccess,
+				},
+			}
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, firstRun, firstRun, reqBatchSize).Return(jobStatus, nil).Once()
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec2].Name, firstRun, firstRun, reqBatchSize).Return(jobStatus, nil).Once()
+			scheduler.On("Clear", ctx, projectSpecs[0], specs[spec1].Name, secondRun, secondRun).Return(nil).Once()
+			scheduler.On("Clear", ctx, projectSpecs[0], specs[spec2].Name, secondRun, secondRun).Return(nil).Once()
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{Size: 1}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should only check status of replayed runs before clearing next batch", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			firstRun := time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC)
+			thirdRun := time.Date(2020, time.Month(8), 24, 2, 0, 0, 0, time.UTC)
+			lastRun := time.Date(2020, time.Month(8), 25, 2, 0, 0, 0, time.UTC)
+			failedRunsTree := tree.NewTreeNode(specs[spec1])
+			failedRunsTree.Runs.Add(firstRun)
+			failedRunsTree.Runs.Add(thirdRun)
+			failedRunsTree.Runs.Add(lastRun)
+			batchedReplaySpec := activeReplaySpec[0]
+			batchedReplaySpec.ExecutionTree = failedRunsTree
+			batchedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2}
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return([]models.ReplaySpec{batchedReplaySpec}, nil)
+			replayRepository.On("UpdateProgress", ctx, activeReplayUUID, models.ReplayProgress{ClearedBatches: 2, TotalBatches: 2}).Return(nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, firstRun, firstRun, reqBatchSize).Return([]models.JobStatus{
+				{ScheduledAt: firstRun, State: models.RunStateFailed},
+			}, nil).Once()
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, thirdRun, thirdRun, reqBatchSize).Return([]models.JobStatus{
+				{ScheduledAt: thirdRun, State: models.RunStateSuccess},
+			}, nil).Once()
+			scheduler.On("Clear", ctx, projectSpecs[0], specs[spec1].Name, lastRun, lastRun).Return(nil).Once()
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{Size: 2}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should not clear next batch of replay while runs of previous batch are running", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			batchedReplaySpec := activeReplaySpec[0]
+			batchedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2}
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return([]models.ReplaySpec{batchedReplaySpec}, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			firstRun := time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC)
+			jobStatus := []models.JobStatus{
+				{
+					ScheduledAt: firstRun,
+					State:       models.RunStateRunning,
+				},
+			}
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, firstRun, firstRun, reqBatchSize).Return(jobStatus, nil).Once()
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{Size: 1}, time.Now, nil)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should skip clearing next batch of replay locked by another server", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			batchedReplaySpec := activeReplaySpec[0]
+			batchedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2}
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return([]models.ReplaySpec{batchedReplaySpec}, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			replayLocker := new(mock.ReplayLocker)
+			defer replayLocker.AssertExpectations(t)
+			replayLocker.On("TryLock", ctx, activeReplayUUID).Return(false, nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{Size: 1}, time.Now, replayLocker)
+			err := replaySyncer.Sync(context.TODO(), runTimeout)
+
+			assert.Nil(t, err)
+		})
+		t.Run("should use progress of replay fetched after acquiring lock to clear next batch", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetAll", ctx).Return(projectSpecs, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			batchedReplaySpec := activeReplaySpec[0]
+			batchedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 1, TotalBatches: 2}
+			clearedReplaySpec := activeReplaySpec[0]
+			clearedReplaySpec.Progress = models.ReplayProgress{ClearedBatches: 2, TotalBatches: 2}
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByProjectIDAndStatus", ctx, projectSpecs[0].ID, job.ReplayStatusToSynced).Return([]models.ReplaySpec{batchedReplaySpec}, nil)
+			replayRepository.On("GetByID", ctx, activeReplayUUID).Return(clearedReplaySpec, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			replayLocker := new(mock.ReplayLocker)
+			defer replayLocker.AssertExpectations(t)
+			replayLocker.On("TryLock", ctx, activeReplayUUID).Return(true, nil)
+			replayLocker.On("Unlock", context.Background(), activeReplayUUID).Return(nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{Size: 1}, time.Now, replayLocker)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Nil(t, err)
@@ -282,7 +550,7 @@ func TestReplaySyncer(t *testing.T) {
 			}
 			replayRepository.On("UpdateStatus", ctx, activeReplayUUID, models.ReplayStatusFailed, failedReplayMessage).Return(nil)
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, nil, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Nil(t, err)
@@ -309,7 +577,7 @@ func TestReplaySyncer(t *testing.T) {
 			errorMsg := "fetch dag run status from batchScheduler failed"
 			scheduler.On("GetJobRunStatus", ctx, projectSpecs[0], specs[spec1].Name, startDate, batchEndDate, reqBatchSize).Return([]models.JobStatus{}, errors.New(errorMsg)).Once()
 
-			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, time.Now)
+			replaySyncer := job.NewReplaySyncer(log, replaySpecRepoFac, projectRepoFactory, scheduler, job.ReplayBatchConfig{}, time.Now, nil)
 			err := replaySyncer.Sync(context.TODO(), runTimeout)
 
 			assert.Contains(t, err.Error(), errorMsg)
@@ -298,6 +298,221 @@ func TestReplay(t *testing.T) {
 		})
 	})
 
+	t.Run("ReplayDryRun with filter", func(t *testing.T) {
+		replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
+		newJobService := func(t *testing.T, jobSpecRepoFac job.SpecRepoFactory) *job.Service {
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll", ctx).Return(dagSpec, nil)
+			t.Cleanup(func() { projectJobSpecRepo.AssertExpectations(t) })
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+
+			depenResolver := new(mock.DependencyResolver)
+			for _, spec := range dagSpec {
+				depenResolver.On("Resolve", ctx, projSpec, spec, nil).Return(spec, nil)
+			}
+			return job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, depenResolver, nil, nil, projJobSpecRepoFac, nil)
+		}
+
+		testCases := []struct {
+			name         string
+			jobName      string
+			filter       models.ReplayFilter
+			expectedJobs []string
+		}{
+			{
+				name:         "should replay only the requested job if root only is set",
+				jobName:      spec1,
+				filter:       models.ReplayFilter{RootOnly: true},
+				expectedJobs: []string{spec1},
+			},
+			{
+				name:         "should replay downstream only till max depth",
+				jobName:      spec1,
+				filter:       models.ReplayFilter{MaxDepth: 1},
+				expectedJobs: []string{spec1, spec2},
+			},
+			{
+				name:         "should skip excluded jobs along with their downstream",
+				jobName:      spec1,
+				filter:       models.ReplayFilter{ExcludeJobs: []string{spec2}},
+				expectedJobs: []string{spec1},
+			},
+			{
+				name:         "should keep downstream reachable through a selected job",
+				jobName:      spec4,
+				filter:       models.ReplayFilter{ExcludeJobs: []string{spec5}},
+				expectedJobs: []string{spec4, spec6},
+			},
+			{
+				name:    "should replay only downstream having the included labels",
+				jobName: spec4,
+				filter: models.ReplayFilter{
+					IncludeLabels: map[string]string{"team": "reporting"},
+				},
+				expectedJobs: []string{spec4},
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				jobSvc := newJobService(t, nil)
+				replayRequest := models.ReplayRequest{
+					Job:     specs[tc.jobName],
+					Start:   replayStart,
+					End:     replayStart,
+					Project: projSpec,
+					Filter:  tc.filter,
+				}
+
+				tree, err := jobSvc.ReplayDryRun(ctx, replayRequest)
+
+				assert.Nil(t, err)
+				var replayedJobs []string
+				for _, node := range tree.GetAllNodes() {
+					replayedJobs = append(replayedJobs, node.GetName())
+				}
+				assert.Equal(t, tc.expectedJobs, replayedJobs)
+			})
+		}
+		t.Run("should replay included jobs reachable through jobs which aren't included", func(t *testing.T) {
+			jobSvc := newJobService(t, nil)
+			replayRequest := models.ReplayRequest{
+				Job:     specs[spec1],
+				Start:   replayStart,
+				End:     replayStart,
+				Project: projSpec,
+				Filter: models.ReplayFilter{
+					IncludeJobs: []string{spec3},
+				},
+			}
+
+			tree, err := jobSvc.ReplayDryRun(ctx, replayRequest)
+
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(tree.Dependents))
+			assert.Equal(t, spec2, tree.Dependents[0].GetName())
+			assert.Equal(t, 0, tree.Dependents[0].Runs.Size())
+			assert.Equal(t, 1, len(tree.Dependents[0].Dependents))
+			assert.Equal(t, spec3, tree.Dependents[0].Dependents[0].GetName())
+			assert.NotEqual(t, 0, tree.Dependents[0].Dependents[0].Runs.Size())
+		})
+		t.Run("should replay only downstream of the included namespaces", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "reporting",
+				ProjectSpec: projSpec,
+			}
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll", ctx).Return([]models.JobSpec{specs[spec5]}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			jobSvc := newJobService(t, jobSpecRepoFac)
+			replayRequest := models.ReplayRequest{
+				Job:     specs[spec4],
+				Start:   replayStart,
+				End:     replayStart,
+				Project: projSpec,
+				Filter: models.ReplayFilter{
+					IncludeNamespaces: []models.NamespaceSpec{namespaceSpec},
+				},
+			}
+
+			tree, err := jobSvc.ReplayDryRun(ctx, replayRequest)
+
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(tree.Dependents))
+			assert.Equal(t, spec5, tree.Dependents[0].GetName())
+			assert.Equal(t, 0, len(tree.Dependents[0].Dependents))
+		})
+	})
+
+	t.Run("ReplayDryRun of failed runs", func(t *testing.T) {
+		replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
+		replayEnd, _ := time.Parse(job.ReplayDateFormat, "2020-08-07")
+		runOn := func(day int) time.Time {
+			return time.Date(2020, time.Month(8), day, 2, 0, 0, 0, time.UTC)
+		}
+		runStatus := func(days []int, failedDay int) []models.JobStatus {
+			var statuses []models.JobStatus
+			for _, day := range days {
+				state := models.RunStateSuccess
+				if day == failedDay {
+					state = models.RunStateFailed
+				}
+				statuses = append(statuses, models.JobStatus{ScheduledAt: runOn(day), State: state})
+			}
+			return statuses
+		}
+		newJobService := func(t *testing.T) *job.Service {
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll", ctx).Return(dagSpec, nil)
+			t.Cleanup(func() { projectJobSpecRepo.AssertExpectations(t) })
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+
+			depenResolver := new(mock.DependencyResolver)
+			for _, spec := range dagSpec {
+				depenResolver.On("Resolve", ctx, projSpec, spec, nil).Return(spec, nil)
+			}
+
+			// only the 6th run of dag2 has failed
+			replayManager := new(mock.ReplayManager)
+			replayManager.On("GetRunStatus", ctx, projSpec, runOn(5), runOn(7), spec1).Return(runStatus([]int{5, 6, 7}, 0), nil)
+			replayManager.On("GetRunStatus", ctx, projSpec, runOn(5), runOn(9), spec2).Return(runStatus([]int{5, 6, 7, 8, 9}, 6), nil)
+			replayManager.On("GetRunStatus", ctx, projSpec, runOn(5), runOn(11), spec3).Return(runStatus([]int{5, 6, 7, 8, 9, 10, 11}, 0), nil)
+			t.Cleanup(func() { replayManager.AssertExpectations(t) })
+			return job.NewService(nil, nil, nil, dumpAssets, depenResolver, nil, nil, projJobSpecRepoFac, replayManager)
+		}
+
+		t.Run("should replay only the failed runs", func(t *testing.T) {
+			jobSvc := newJobService(t)
+			replayRequest := models.ReplayRequest{
+				Job:            specs[spec1],
+				Start:          replayStart,
+				End:            replayEnd,
+				Project:        projSpec,
+				FailedRunsOnly: true,
+			}
+
+			tree, err := jobSvc.ReplayDryRun(ctx, replayRequest)
+
+			assert.Nil(t, err)
+			countMap := make(map[string][]time.Time)
+			getRuns(tree, countMap)
+			assert.Equal(t, map[string][]time.Time{
+				spec2: {runOn(6)},
+			}, countMap)
+			assert.Equal(t, 2, len(tree.GetAllNodes()))
+		})
+		t.Run("should replay the failed runs along with their downstream runs", func(t *testing.T) {
+			jobSvc := newJobService(t)
+			replayRequest := models.ReplayRequest{
+				Job:                  specs[spec1],
+				Start:                replayStart,
+				End:                  replayEnd,
+				Project:              projSpec,
+				FailedRunsOnly:       true,
+				FailedRunsDownstream: true,
+			}
+
+			tree, err := jobSvc.ReplayDryRun(ctx, replayRequest)
+
+			assert.Nil(t, err)
+			countMap := make(map[string][]time.Time)
+			getRuns(tree, countMap)
+			assert.Equal(t, map[string][]time.Time{
+				spec2: {runOn(6)},
+				spec3: {runOn(6), runOn(7), runOn(8)},
+			}, countMap)
+		})
+	})
+
 	t.Run("Replay", func(t *testing.T) {
 		t.Run("should fail if unable to fetch jobSpecs from project jobSpecRepo", func(t *testing.T) {
 			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
@@ -2,11 +2,9 @@ package job
 
 import (
 	"context"
-	"time"
 
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/salt/log"
-	"github.com/pkg/errors"
 )
 
 const (
@@ -20,28 +18,37 @@ type ReplayWorker interface {
 type replayWorker struct {
 	replaySpecRepoFac ReplaySpecRepoFactory
 	scheduler         models.SchedulerUnit
+	batchConfig       ReplayBatchConfig
 	log               log.Logger
 }
 
 func (w *replayWorker) Process(ctx context.Context, input models.ReplayRequest) (err error) {
 	replaySpecRepo := w.replaySpecRepoFac.New()
-	// mark replay request in progress
-	if inProgressErr := replaySpecRepo.UpdateStatus(ctx, input.ID, models.ReplayStatusInProgress, models.ReplayMessage{}); inProgressErr != nil {
+	// mark replay request in progress unless it got cancelled
+	inProgress, inProgressErr := replaySpecRepo.UpdateStatusFrom(ctx, input.ID, ReplayStatusToRecover,
+		models.ReplayStatusInProgress, models.ReplayMessage{})
+	if inProgressErr != nil {
 		return inProgressErr
 	}
+	if !inProgress {
+		w.log.Info("replay cancelled before clearing runs", "replay id", input.ID.String())
+		return nil
+	}
 
 	replaySpec, err := replaySpecRepo.GetByID(ctx, input.ID)
 	if err != nil {
 		return err
 	}
 
-	replayDagsMap := replaySpec.ExecutionTree.GetAllNodes()
-	for _, treeNode := range replayDagsMap {
-		runTimes := treeNode.Runs.Values()
-		startTime := runTimes[0].(time.Time)
-		endTime := runTimes[treeNode.Runs.Size()-1].(time.Time)
-		if err = w.scheduler.Clear(ctx, input.Project, treeNode.GetName(), startTime, endTime); err != nil {
-			err = errors.Wrapf(err, "error while clearing dag runs for job %s", treeNode.GetName())
+	// only the first batch is cleared here, syncer clears the rest once
+	// runs of the previous batch are finished
+	batches := w.batchConfig.batches(replaySpec)
+	if len(batches) > 0 {
+		if err = clearReplayBatch(ctx, w.scheduler, input.Project, replaySpec, batches[0]); err != nil {
+			if isReplayCancelled(ctx) {
+				w.log.Info("replay cancelled while clearing runs", "replay id", input.ID.String())
+				return nil
+			}
 			w.log.Warn("error while running replay", "replay id", input.ID.String(), "error", err.Error())
 			if updateStatusErr := replaySpecRepo.UpdateStatus(ctx, input.ID, models.ReplayStatusFailed, models.ReplayMessage{
 				Type:    AirflowClearDagRunFailed,
@@ -52,18 +59,44 @@ func (w *replayWorker) Process(ctx context.Context, input models.ReplayRequest)
 			return err
 		}
 	}
+	if len(batches) > 1 {
+		if err = replaySpecRepo.UpdateProgress(ctx, input.ID, models.ReplayProgress{
+			ClearedBatches: 1,
+			TotalBatches:   len(batches),
+		}); err != nil {
+			return err
+		}
+	}
 
-	if err = replaySpecRepo.UpdateStatus(ctx, input.ID, models.ReplayStatusReplayed, models.ReplayMessage{}); err != nil {
+	if isReplayCancelled(ctx) {
+		w.log.Info("replay cancelled while clearing runs", "replay id", input.ID.String())
+		return nil
+	}
+	replayed, err := replaySpecRepo.UpdateStatusFrom(ctx, input.ID, []string{models.ReplayStatusInProgress},
+		models.ReplayStatusReplayed, models.ReplayMessage{})
+	if err != nil {
 		return err
 	}
+	if !replayed {
+		w.log.Info("replay cancelled while clearing runs", "replay id", input.ID.String())
+		return nil
+	}
 	w.log.Info("successfully cleared instances during replay", "replay id", input.ID.String())
 	return nil
 }
 
-func NewReplayWorker(l log.Logger, replaySpecRepoFac ReplaySpecRepoFactory, scheduler models.SchedulerUnit) *replayWorker {
+// isReplayCancelled reports if the replay got cancelled by user, status of
+// cancelled replays is already updated by the canceller
+func isReplayCancelled(ctx context.Context) bool {
+	return ctx.Err() == context.Canceled
+}
+
+func NewReplayWorker(l log.Logger, replaySpecRepoFac ReplaySpecRepoFactory, scheduler models.SchedulerUnit,
+	batchConfig ReplayBatchConfig) *replayWorker {
 	return &replayWorker{
 		log:               l,
 		replaySpecRepoFac: replaySpecRepoFac,
 		scheduler:         scheduler,
+		batchConfig:       batchConfig,
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/odpf/optimus/models"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
 )
 
 func TestReplayWorker(t *testing.T) {
@@ -63,13 +64,13 @@ func TestReplayWorker(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
 			errMessage := "replay repo error"
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(errors.New(errMessage))
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(false, errors.New(errMessage))
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New").Return(replayRepository)
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, nil)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, nil, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Equal(t, errMessage, err.Error())
@@ -78,7 +79,7 @@ func TestReplayWorker(t *testing.T) {
 			ctx := context.Background()
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
 			errMessage := "error while clearing dag runs for job job-name: batchScheduler clear error"
 			failedReplayMessage := models.ReplayMessage{
 				Type:    job.AirflowClearDagRunFailed,
@@ -96,7 +97,7 @@ func TestReplayWorker(t *testing.T) {
 			errorMessage := "batchScheduler clear error"
 			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).Return(errors.New(errorMessage))
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errorMessage)
@@ -105,7 +106,7 @@ func TestReplayWorker(t *testing.T) {
 			ctx := context.Background()
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
 			errMessage := "error while clearing dag runs for job job-name: batchScheduler clear error"
 			failedReplayMessage := models.ReplayMessage{
 				Type:    job.AirflowClearDagRunFailed,
@@ -124,7 +125,7 @@ func TestReplayWorker(t *testing.T) {
 			errorMessage := "batchScheduler clear error"
 			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).Return(errors.New(errorMessage))
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), updateStatusErr.Error())
@@ -133,9 +134,9 @@ func TestReplayWorker(t *testing.T) {
 			ctx := context.Background()
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
 			updateSuccessStatusErr := errors.New("error while updating replay request")
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(updateSuccessStatusErr)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, []string{models.ReplayStatusInProgress}, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(false, updateSuccessStatusErr)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
@@ -146,7 +147,7 @@ func TestReplayWorker(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).Return(nil)
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), updateSuccessStatusErr.Error())
@@ -154,8 +155,8 @@ func TestReplayWorker(t *testing.T) {
 		t.Run("should update replay status if successful", func(t *testing.T) {
 			ctx := context.Background()
 			replayRepository := new(mock.ReplayRepository)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(nil)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, []string{models.ReplayStatusInProgress}, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(true, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
@@ -166,7 +167,115 @@ func TestReplayWorker(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).Return(nil)
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
+			err := worker.Process(ctx, replayRequest)
+			assert.Nil(t, err)
+		})
+		t.Run("should not clear runs if replay is cancelled before being processed", func(t *testing.T) {
+			ctx := context.Background()
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(false, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
+			err := worker.Process(ctx, replayRequest)
+			assert.Nil(t, err)
+		})
+		t.Run("should not mark replay as replayed if it is cancelled while clearing runs", func(t *testing.T) {
+			ctx := context.Background()
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, []string{models.ReplayStatusInProgress}, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(false, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			replayRepository.On("GetByID", ctx, currUUID).Return(replaySpec, nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).Return(nil)
+
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
+			err := worker.Process(ctx, replayRequest)
+			assert.Nil(t, err)
+		})
+		t.Run("should clear runs separated by runs not being replayed separately", func(t *testing.T) {
+			ctx := context.Background()
+			failedRunsTree := tree.NewTreeNode(jobSpec)
+			failedRunsTree.Runs.Add(time.Date(2020, time.Month(8), 22, 2, 0, 0, 0, time.UTC))
+			failedRunsTree.Runs.Add(time.Date(2020, time.Month(8), 23, 2, 0, 0, 0, time.UTC))
+			failedRunsTree.Runs.Add(time.Date(2020, time.Month(8), 25, 2, 0, 0, 0, time.UTC))
+			failedRunsReplaySpec := replaySpec
+			failedRunsReplaySpec.ExecutionTree = failedRunsTree
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, []string{models.ReplayStatusInProgress}, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			replayRepository.On("GetByID", ctx, currUUID).Return(failedRunsReplaySpec, nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunStartTime.AddDate(0, 0, 1)).Return(nil).Once()
+			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime.AddDate(0, 0, 3), dagRunStartTime.AddDate(0, 0, 3)).Return(nil).Once()
+
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
+			err := worker.Process(ctx, replayRequest)
+			assert.Nil(t, err)
+		})
+		t.Run("should clear only the first batch and store progress when runs are batched", func(t *testing.T) {
+			ctx := context.Background()
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
+			replayRepository.On("UpdateProgress", ctx, currUUID, models.ReplayProgress{ClearedBatches: 1, TotalBatches: 3}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, []string{models.ReplayStatusInProgress}, models.ReplayStatusReplayed, models.ReplayMessage{}).Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			replayRepository.On("GetByID", ctx, currUUID).Return(replaySpec, nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunStartTime.AddDate(0, 0, 1)).Return(nil).Once()
+
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{Size: 2})
+			err := worker.Process(ctx, replayRequest)
+			assert.Nil(t, err)
+		})
+		t.Run("should stop clearing runs without updating status when replay is cancelled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New").Return(replayRepository)
+			replayRepository.On("GetByID", ctx, currUUID).Return(replaySpec, nil)
+
+			scheduler := new(mock.Scheduler)
+			defer scheduler.AssertExpectations(t)
+			scheduler.On("Clear", ctx, projectSpec, "job-name", dagRunStartTime, dagRunEndTime).
+				Run(func(args mocklib.Arguments) { cancel() }).
+				Return(context.Canceled)
+
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.Nil(t, err)
 		})
@@ -174,7 +283,7 @@ func TestReplayWorker(t *testing.T) {
 			ctx := context.Background()
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("UpdateStatus", ctx, currUUID, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(nil)
+			replayRepository.On("UpdateStatusFrom", ctx, currUUID, job.ReplayStatusToRecover, models.ReplayStatusInProgress, models.ReplayMessage{}).Return(true, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
@@ -185,7 +294,7 @@ func TestReplayWorker(t *testing.T) {
 			scheduler := new(mock.Scheduler)
 			defer scheduler.AssertExpectations(t)
 
-			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler)
+			worker := job.NewReplayWorker(log, replaySpecRepoFac, scheduler, job.ReplayBatchConfig{})
 			err := worker.Process(ctx, replayRequest)
 			assert.Equal(t, errMessage, err.Error())
 		})
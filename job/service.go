@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"strings"
 	"time"
@@ -24,6 +25,10 @@ const (
 
 	ConcurrentTicketPerSec = 40
 	ConcurrentLimit        = 600
+
+	// JobTemplateSampleSize is the number of jobs of a project used to
+	// verify its job template
+	JobTemplateSampleSize = 5
 )
 
 type AssetCompiler func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error)
@@ -65,6 +70,7 @@ type ReplayManager interface {
 	GetReplayList(ctx context.Context, projectID uuid.UUID) ([]models.ReplaySpec, error)
 	GetRunStatus(ctx context.Context, projectSpec models.ProjectSpec, startDate time.Time, endDate time.Time,
 		jobName string) ([]models.JobStatus, error)
+	Cancel(ctx context.Context, projectSpec models.ProjectSpec, replayID uuid.UUID, failRuns bool) error
 }
 
 // Service compiles all jobs with its dependencies, priority and
@@ -195,26 +201,77 @@ func (srv *Service) Delete(ctx context.Context, namespace models.NamespaceSpec,
 	return srv.batchScheduler.DeleteJobs(ctx, namespace, []string{jobSpec.Name}, nil)
 }
 
+// Pause stops batch scheduler from scheduling the job and persists it as
+// paused so that it stays paused across deployments
+func (srv *Service) Pause(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
+	if err := srv.batchScheduler.Pause(ctx, namespace, jobSpec.Name); err != nil {
+		return errors.Wrapf(err, "failed to pause job: %s", jobSpec.Name)
+	}
+	if err := srv.jobSpecRepoFactory.New(namespace).SetPaused(ctx, jobSpec.Name, true); err != nil {
+		return errors.Wrapf(err, "failed to save paused state of job: %s", jobSpec.Name)
+	}
+	return nil
+}
+
+// Resume lets batch scheduler schedule a paused job again
+func (srv *Service) Resume(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
+	if err := srv.batchScheduler.Resume(ctx, namespace, jobSpec.Name); err != nil {
+		return errors.Wrapf(err, "failed to resume job: %s", jobSpec.Name)
+	}
+	if err := srv.jobSpecRepoFactory.New(namespace).SetPaused(ctx, jobSpec.Name, false); err != nil {
+		return errors.Wrapf(err, "failed to save paused state of job: %s", jobSpec.Name)
+	}
+	return nil
+}
+
+// TriggerRun creates an adhoc run of the job on batch scheduler
+func (srv *Service) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, scheduledAt time.Time,
+	config map[string]string) error {
+	if err := srv.batchScheduler.TriggerRun(ctx, namespace, jobSpec.Name, scheduledAt, config); err != nil {
+		return errors.Wrapf(err, "failed to trigger run of job: %s", jobSpec.Name)
+	}
+	return nil
+}
+
+// CheckJobTemplate compiles a few jobs of the project with the template, there
+// is nothing to verify it with if the project has no jobs yet
+func (srv *Service) CheckJobTemplate(ctx context.Context, projectSpec models.ProjectSpec, template []byte) error {
+	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(projectSpec)
+	jobSpecs, err := projectJobSpecRepo.GetAll(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch jobs of project %s", projectSpec.Name)
+	}
+
+	projectSpec.JobTemplate = template
+	for idx, jobSpec := range jobSpecs {
+		if idx == JobTemplateSampleSize {
+			break
+		}
+		_, namespaceSpec, err := projectJobSpecRepo.GetByName(ctx, jobSpec.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find namespace of job %s", jobSpec.Name)
+		}
+		namespaceSpec.ProjectSpec = projectSpec
+
+		if jobSpec.Assets, err = srv.assetCompiler(jobSpec, srv.Now()); err != nil {
+			return errors.Wrapf(err, "failed to compile assets of job %s", jobSpec.Name)
+		}
+		// dependencies are not resolved for stored specs
+		jobSpec.Dependencies = map[string]models.JobSpecDependency{}
+		if err := srv.batchScheduler.VerifyJob(ctx, namespaceSpec, jobSpec); err != nil {
+			return errors.Wrapf(err, "failed to compile job %s with template", jobSpec.Name)
+		}
+	}
+	return nil
+}
+
 // Sync fetches all the jobs that belong to a project, resolves its dependencies
 // assign proper priority weights, compiles it and uploads it to the destination
 // store.
 // It syncs the internal store state with destination batch batchScheduler by deleting
 // what is not needed anymore
 func (srv *Service) Sync(ctx context.Context, namespace models.NamespaceSpec, progressObserver progress.Observer) error {
-	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(namespace.ProjectSpec)
-	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, namespace.ProjectSpec, projectJobSpecRepo, progressObserver)
-	if err != nil {
-		return err
-	}
-	srv.notifyProgress(progressObserver, &EventJobSpecDependencyResolve{})
-
-	jobSpecs, err = srv.priorityResolver.Resolve(ctx, jobSpecs)
-	if err != nil {
-		return err
-	}
-	srv.notifyProgress(progressObserver, &EventJobPriorityWeightAssign{})
-
-	jobSpecs, err = srv.filterJobSpecForNamespace(ctx, jobSpecs, namespace)
+	jobSpecs, err := srv.getDeployableSpecs(ctx, namespace, progressObserver)
 	if err != nil {
 		return err
 	}
@@ -252,6 +309,91 @@ func (srv *Service) Sync(ctx context.Context, namespace models.NamespaceSpec, pr
 	return nil
 }
 
+// getDeployableSpecs returns job specs of the namespace with their
+// dependencies and priorities resolved as they are deployed on scheduler
+func (srv *Service) getDeployableSpecs(ctx context.Context, namespace models.NamespaceSpec, progressObserver progress.Observer) ([]models.JobSpec, error) {
+	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(namespace.ProjectSpec)
+	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, namespace.ProjectSpec, projectJobSpecRepo, progressObserver)
+	if err != nil {
+		return nil, err
+	}
+	srv.notifyProgress(progressObserver, &EventJobSpecDependencyResolve{})
+
+	jobSpecs, err = srv.priorityResolver.Resolve(ctx, jobSpecs)
+	if err != nil {
+		return nil, err
+	}
+	srv.notifyProgress(progressObserver, &EventJobPriorityWeightAssign{})
+
+	return srv.filterJobSpecForNamespace(ctx, jobSpecs, namespace)
+}
+
+// GetDrift compares jobs deployed on batch scheduler with the specs stored
+// for the namespace. Jobs which are deployed without a spec are orphaned,
+// specs which are not deployed are missing and if scheduler compiles the
+// specs, deployed jobs whose contents don't match a fresh compilation are
+// modified. If asked to repair, missing and modified jobs are deployed
+// again and orphaned jobs are deleted from scheduler
+func (srv *Service) GetDrift(ctx context.Context, namespace models.NamespaceSpec, repair bool) (models.JobDrift, error) {
+	drift := models.JobDrift{}
+	jobSpecs, err := srv.getDeployableSpecs(ctx, namespace, nil)
+	if err != nil {
+		return drift, err
+	}
+
+	compilingScheduler, canCompile := srv.batchScheduler.(models.JobCompilingScheduler)
+	deployedJobs, err := srv.batchScheduler.ListJobs(ctx, namespace, models.SchedulerListOptions{OnlyName: !canCompile})
+	if err != nil {
+		return drift, errors.Wrapf(err, "failed to list deployed jobs of namespace %s", namespace.Name)
+	}
+	deployedHashes := map[string]string{}
+	var deployedJobNames []string
+	for _, deployedJob := range deployedJobs {
+		deployedHashes[deployedJob.Name] = contentHash(deployedJob.Contents)
+		deployedJobNames = append(deployedJobNames, deployedJob.Name)
+	}
+
+	var jobSpecNames []string
+	var specsToDeploy []models.JobSpec
+	for _, jobSpec := range jobSpecs {
+		jobSpecNames = append(jobSpecNames, jobSpec.Name)
+		deployedHash, deployed := deployedHashes[jobSpec.Name]
+		if !deployed {
+			drift.Missing = append(drift.Missing, jobSpec.Name)
+			specsToDeploy = append(specsToDeploy, jobSpec)
+			continue
+		}
+		if !canCompile {
+			continue
+		}
+		compiledJob, err := compilingScheduler.CompileJob(ctx, namespace, jobSpec)
+		if err != nil {
+			return drift, errors.Wrapf(err, "failed to compile job: %s", jobSpec.Name)
+		}
+		if contentHash(compiledJob.Contents) != deployedHash {
+			drift.Modified = append(drift.Modified, jobSpec.Name)
+			specsToDeploy = append(specsToDeploy, jobSpec)
+		}
+	}
+	drift.Orphaned = jobDeletionFilter(setSubtract(deployedJobNames, jobSpecNames))
+
+	if !repair || !drift.HasDrift() {
+		return drift, nil
+	}
+	if len(specsToDeploy) > 0 {
+		if err := srv.batchScheduler.DeployJobs(ctx, namespace, specsToDeploy, nil); err != nil {
+			return drift, errors.Wrapf(err, "failed to deploy drifted jobs of namespace %s", namespace.Name)
+		}
+	}
+	if len(drift.Orphaned) > 0 {
+		if err := srv.batchScheduler.DeleteJobs(ctx, namespace, drift.Orphaned, nil); err != nil {
+			return drift, errors.Wrapf(err, "failed to delete orphaned jobs of namespace %s", namespace.Name)
+		}
+	}
+	drift.Repaired = true
+	return drift, nil
+}
+
 // KeepOnly only keeps the provided jobSpecs in argument and deletes rest from spec repository
 func (srv *Service) KeepOnly(ctx context.Context, namespace models.NamespaceSpec, specsToKeep []models.JobSpec, progressObserver progress.Observer) error {
 	jobSpecRepo := srv.jobSpecRepoFactory.New(namespace)
@@ -428,6 +570,11 @@ func (srv *Service) notifyProgress(po progress.Observer, event progress.Event) {
 	po.Notify(event)
 }
 
+// contentHash is used to compare contents of compiled jobs
+func contentHash(contents []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(contents))
+}
+
 // remove items present in from
 func setSubtract(from []string, remove []string) []string {
 	removeMap := make(map[string]bool)
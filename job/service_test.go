@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -555,6 +556,245 @@ func TestService(t *testing.T) {
 		})
 	})
 
+	t.Run("TriggerRun", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-team-1",
+			ProjectSpec: models.ProjectSpec{Name: "proj"},
+		}
+		jobSpec := models.JobSpec{
+			Name: "test",
+		}
+		scheduledAt := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+		runConfig := map[string]string{"BACKFILL": "true"}
+
+		t.Run("should trigger run of the job on batch scheduler", func(t *testing.T) {
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("TriggerRun", ctx, namespaceSpec, jobSpec.Name, scheduledAt, runConfig).Return(nil)
+			defer batchScheduler.AssertExpectations(t)
+
+			svc := job.NewService(nil, batchScheduler, nil, nil, nil, nil, nil, nil, nil)
+			err := svc.TriggerRun(ctx, namespaceSpec, jobSpec, scheduledAt, runConfig)
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if scheduler fails to trigger the run", func(t *testing.T) {
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("TriggerRun", ctx, namespaceSpec, jobSpec.Name, scheduledAt, runConfig).Return(errors.New("run already exists"))
+			defer batchScheduler.AssertExpectations(t)
+
+			svc := job.NewService(nil, batchScheduler, nil, nil, nil, nil, nil, nil, nil)
+			err := svc.TriggerRun(ctx, namespaceSpec, jobSpec, scheduledAt, runConfig)
+			assert.Equal(t, "failed to trigger run of job: test: run already exists", err.Error())
+		})
+	})
+	t.Run("CheckJobTemplate", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{Name: "proj"}
+		template := []byte("{{ .Job.Name }}")
+		assetCompiler := func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
+			return jobSpec.Assets, nil
+		}
+
+		t.Run("should compile a sample of jobs of the project with the template", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{
+				Name:        "dev-team-1",
+				ProjectSpec: projectSpec,
+			}
+			templatedNamespaceSpec := namespaceSpec
+			templatedNamespaceSpec.ProjectSpec.JobTemplate = template
+
+			var jobSpecs []models.JobSpec
+			for idx := 0; idx < job.JobTemplateSampleSize+1; idx++ {
+				jobSpecs = append(jobSpecs, models.JobSpec{Name: fmt.Sprintf("job-%d", idx)})
+			}
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetAll", ctx).Return(jobSpecs, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			batchScheduler := new(mock.Scheduler)
+			defer batchScheduler.AssertExpectations(t)
+			for _, jobSpec := range jobSpecs[:job.JobTemplateSampleSize] {
+				projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+				jobSpec.Dependencies = map[string]models.JobSpecDependency{}
+				batchScheduler.On("VerifyJob", ctx, templatedNamespaceSpec, jobSpec).Return(nil)
+			}
+
+			svc := job.NewService(nil, batchScheduler, nil, assetCompiler, nil, nil, nil, projJobSpecRepoFac, nil)
+			err := svc.CheckJobTemplate(ctx, projectSpec, template)
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if a job fails to compile with the template", func(t *testing.T) {
+			namespaceSpec := models.NamespaceSpec{
+				Name:        "dev-team-1",
+				ProjectSpec: projectSpec,
+			}
+			templatedNamespaceSpec := namespaceSpec
+			templatedNamespaceSpec.ProjectSpec.JobTemplate = template
+			jobSpec := models.JobSpec{Name: "job-1"}
+
+			projJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projJobSpecRepo.On("GetAll", ctx).Return([]models.JobSpec{jobSpec}, nil)
+			projJobSpecRepo.On("GetByName", ctx, jobSpec.Name).Return(jobSpec, namespaceSpec, nil)
+			defer projJobSpecRepo.AssertExpectations(t)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projectSpec).Return(projJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			batchScheduler := new(mock.Scheduler)
+			verifiedSpec := jobSpec
+			verifiedSpec.Dependencies = map[string]models.JobSpecDependency{}
+			batchScheduler.On("VerifyJob", ctx, templatedNamespaceSpec, verifiedSpec).Return(errors.New("unexpected EOF"))
+			defer batchScheduler.AssertExpectations(t)
+
+			svc := job.NewService(nil, batchScheduler, nil, assetCompiler, nil, nil, nil, projJobSpecRepoFac, nil)
+			err := svc.CheckJobTemplate(ctx, projectSpec, template)
+			assert.Equal(t, "failed to compile job job-1 with template: unexpected EOF", err.Error())
+		})
+	})
+	t.Run("Pause", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-team-1",
+			ProjectSpec: models.ProjectSpec{Name: "proj"},
+		}
+		jobSpec := models.JobSpec{
+			Name: "test",
+		}
+
+		t.Run("should pause job in scheduler and persist it as paused", func(t *testing.T) {
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("Pause", ctx, namespaceSpec, jobSpec.Name).Return(nil)
+			defer batchScheduler.AssertExpectations(t)
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("SetPaused", ctx, jobSpec.Name, true).Return(nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			svc := job.NewService(jobSpecRepoFac, batchScheduler, nil, nil, nil, nil, nil, nil, nil)
+			err := svc.Pause(ctx, namespaceSpec, jobSpec)
+			assert.Nil(t, err)
+		})
+		t.Run("should not persist job as paused if scheduler fails to pause it", func(t *testing.T) {
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("Pause", ctx, namespaceSpec, jobSpec.Name).Return(errors.New("dag not found"))
+			defer batchScheduler.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			svc := job.NewService(jobSpecRepoFac, batchScheduler, nil, nil, nil, nil, nil, nil, nil)
+			err := svc.Pause(ctx, namespaceSpec, jobSpec)
+			assert.Equal(t, "failed to pause job: test: dag not found", err.Error())
+		})
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-team-1",
+			ProjectSpec: models.ProjectSpec{Name: "proj"},
+		}
+		jobSpec := models.JobSpec{
+			Name:   "test",
+			Paused: true,
+		}
+
+		t.Run("should resume job in scheduler and persist it as not paused", func(t *testing.T) {
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("Resume", ctx, namespaceSpec, jobSpec.Name).Return(nil)
+			defer batchScheduler.AssertExpectations(t)
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("SetPaused", ctx, jobSpec.Name, false).Return(nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			svc := job.NewService(jobSpecRepoFac, batchScheduler, nil, nil, nil, nil, nil, nil, nil)
+			err := svc.Resume(ctx, namespaceSpec, jobSpec)
+			assert.Nil(t, err)
+		})
+	})
+
+	t.Run("GetDrift", func(t *testing.T) {
+		projSpec := models.ProjectSpec{
+			Name: "proj",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-team-1",
+			ProjectSpec: projSpec,
+		}
+		jobSpecs := []models.JobSpec{
+			{Name: "in-sync"},
+			{Name: "modified"},
+			{Name: "missing"},
+		}
+		deployedJobs := []models.Job{
+			{Name: "in-sync", Contents: []byte("in-sync-dag")},
+			{Name: "modified", Contents: []byte("old-dag")},
+			{Name: "orphaned", Contents: []byte("orphaned-dag")},
+			{Name: job.PersistJobPrefix + "kept", Contents: []byte("kept-dag")},
+		}
+		setup := func(t *testing.T) (*mock.JobSpecRepoFactory, *mock.DependencyResolver, *mock.PriorityResolver, *mock.ProjectJobSpecRepoFactory, *mock.Scheduler) {
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll", ctx).Return(jobSpecs, nil)
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll", ctx).Return(jobSpecs, nil)
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+
+			depenResolver := new(mock.DependencyResolver)
+			for _, jobSpec := range jobSpecs {
+				depenResolver.On("Resolve", ctx, projSpec, jobSpec, nil).Return(jobSpec, nil)
+			}
+			priorityResolver := new(mock.PriorityResolver)
+			priorityResolver.On("Resolve", ctx, jobSpecs).Return(jobSpecs, nil)
+
+			batchScheduler := new(mock.Scheduler)
+			batchScheduler.On("ListJobs", ctx, namespaceSpec, models.SchedulerListOptions{OnlyName: false}).Return(deployedJobs, nil)
+			batchScheduler.On("CompileJob", ctx, namespaceSpec, jobSpecs[0]).Return(models.Job{Name: "in-sync", Contents: []byte("in-sync-dag")}, nil)
+			batchScheduler.On("CompileJob", ctx, namespaceSpec, jobSpecs[1]).Return(models.Job{Name: "modified", Contents: []byte("new-dag")}, nil)
+			return jobSpecRepoFac, depenResolver, priorityResolver, projJobSpecRepoFac, batchScheduler
+		}
+
+		t.Run("should report orphaned, missing and modified jobs", func(t *testing.T) {
+			jobSpecRepoFac, depenResolver, priorityResolver, projJobSpecRepoFac, batchScheduler := setup(t)
+			defer batchScheduler.AssertExpectations(t)
+
+			svc := job.NewService(jobSpecRepoFac, batchScheduler, nil, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
+			drift, err := svc.GetDrift(ctx, namespaceSpec, false)
+			assert.Nil(t, err)
+			assert.Equal(t, models.JobDrift{
+				Orphaned: []string{"orphaned"},
+				Missing:  []string{"missing"},
+				Modified: []string{"modified"},
+			}, drift)
+		})
+		t.Run("should deploy drifted jobs and delete orphaned jobs if asked to repair", func(t *testing.T) {
+			jobSpecRepoFac, depenResolver, priorityResolver, projJobSpecRepoFac, batchScheduler := setup(t)
+			batchScheduler.On("DeployJobs", ctx, namespaceSpec, []models.JobSpec{jobSpecs[1], jobSpecs[2]}, nil).Return(nil)
+			batchScheduler.On("DeleteJobs", ctx, namespaceSpec, []string{"orphaned"}, nil).Return(nil)
+			defer batchScheduler.AssertExpectations(t)
+
+			svc := job.NewService(jobSpecRepoFac, batchScheduler, nil, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
+			drift, err := svc.GetDrift(ctx, namespaceSpec, true)
+			assert.Nil(t, err)
+			assert.True(t, drift.Repaired)
+		})
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		projSpec := models.ProjectSpec{
 			Name: "proj",
@@ -35,6 +35,11 @@ func (r *JobRunRepository) GetByScheduledAt(ctx context.Context, jobID uuid.UUID
 	return args.Get(0).(models.JobRun), args.Get(1).(models.NamespaceSpec), args.Error(2)
 }
 
+func (r *JobRunRepository) GetPrevious(ctx context.Context, jobID uuid.UUID, scheduledAt time.Time, trigger models.JobRunTrigger) (models.JobRun, models.NamespaceSpec, error) {
+	args := r.Called(ctx, jobID, scheduledAt, trigger)
+	return args.Get(0).(models.JobRun), args.Get(1).(models.NamespaceSpec), args.Error(2)
+}
+
 func (r *JobRunRepository) GetByID(ctx context.Context, u uuid.UUID) (models.JobRun, models.NamespaceSpec, error) {
 	args := r.Called(ctx, u)
 	return args.Get(0).(models.JobRun), args.Get(1).(models.NamespaceSpec), args.Error(2)
@@ -45,6 +50,11 @@ func (r *JobRunRepository) UpdateStatus(ctx context.Context, u uuid.UUID, state
 	return args.Error(0)
 }
 
+func (r *JobRunRepository) UpdateStatusFrom(ctx context.Context, runID uuid.UUID, from, to models.JobRunState) (bool, error) {
+	args := r.Called(ctx, runID, from, to)
+	return args.Bool(0), args.Error(1)
+}
+
 func (r *JobRunRepository) GetByStatus(ctx context.Context, state ...models.JobRunState) ([]models.JobRun, error) {
 	args := r.Called(ctx, state)
 	return args.Get(0).([]models.JobRun), args.Error(1)
@@ -55,6 +65,16 @@ func (r *JobRunRepository) GetByTrigger(ctx context.Context, trig models.JobRunT
 	return args.Get(0).([]models.JobRun), args.Error(1)
 }
 
+func (r *JobRunRepository) GetByJob(ctx context.Context, jobID uuid.UUID, startDate, endDate time.Time) ([]models.JobRun, error) {
+	args := r.Called(ctx, jobID, startDate, endDate)
+	return args.Get(0).([]models.JobRun), args.Error(1)
+}
+
+func (r *JobRunRepository) GetLatestByNamespace(ctx context.Context, namespaceID uuid.UUID) ([]models.JobRun, error) {
+	args := r.Called(ctx, namespaceID)
+	return args.Get(0).([]models.JobRun), args.Error(1)
+}
+
 func (r *JobRunRepository) Delete(ctx context.Context, u uuid.UUID) error {
 	args := r.Called(ctx, u)
 	return args.Error(0)
@@ -75,11 +95,51 @@ func (r *JobRunRepository) ClearInstance(ctx context.Context, runID uuid.UUID, i
 	return args.Error(0)
 }
 
+func (r *JobRunRepository) GetInstanceAttempts(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) ([]models.InstanceSpec, error) {
+	args := r.Called(ctx, runID, instanceType, instanceName)
+	return args.Get(0).([]models.InstanceSpec), args.Error(1)
+}
+
 func (r *JobRunRepository) ClearInstances(ctx context.Context, jobID uuid.UUID, scheduled time.Time) error {
 	args := r.Called(ctx, jobID, scheduled)
 	return args.Error(0)
 }
 
+// InstanceRepository to store instances of job runs
+type InstanceRepository struct {
+	mock.Mock
+}
+
+func (repo *InstanceRepository) Save(ctx context.Context, run models.JobRun, spec models.InstanceSpec) error {
+	return repo.Called(ctx, run, spec).Error(0)
+}
+
+func (repo *InstanceRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status models.JobRunState) error {
+	return repo.Called(ctx, id, status).Error(0)
+}
+
+func (repo *InstanceRepository) GetByName(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) (models.InstanceSpec, error) {
+	args := repo.Called(ctx, runID, instanceName, instanceType)
+	return args.Get(0).(models.InstanceSpec), args.Error(1)
+}
+
+func (repo *InstanceRepository) DeleteByJobRun(ctx context.Context, id uuid.UUID) error {
+	return repo.Called(ctx, id).Error(0)
+}
+
+type InstanceLogRepository struct {
+	mock.Mock
+}
+
+func (repo *InstanceLogRepository) Save(ctx context.Context, instanceID uuid.UUID, logs []byte) error {
+	return repo.Called(ctx, instanceID, logs).Error(0)
+}
+
+func (repo *InstanceLogRepository) GetByInstanceID(ctx context.Context, instanceID uuid.UUID) ([]byte, error) {
+	args := repo.Called(ctx, instanceID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 type InstanceSpecRepoFactory struct {
 	mock.Mock
 }
@@ -129,6 +189,27 @@ func (s *RunService) Register(ctx context.Context, namespace models.NamespaceSpe
 	return args.Get(0).(models.InstanceSpec), args.Error(1)
 }
 
+func (s *RunService) PrepInstance(ctx context.Context, jobRun models.JobRun, instanceType models.InstanceType, instanceName string) (models.InstanceSpec, error) {
+	args := s.Called(ctx, jobRun, instanceType, instanceName)
+	return args.Get(0).(models.InstanceSpec), args.Error(1)
+}
+
+func (s *RunService) UpdateInstanceStatus(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, scheduledAt time.Time,
+	instanceType models.InstanceType, instanceName string, status models.JobRunState) error {
+	return s.Called(ctx, namespace, jobSpec, scheduledAt, instanceType, instanceName, status).Error(0)
+}
+
+func (s *RunService) Cancel(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, scheduledAt time.Time) (models.JobRun, error) {
+	args := s.Called(ctx, namespace, jobSpec, scheduledAt)
+	return args.Get(0).(models.JobRun), args.Error(1)
+}
+
+func (s *RunService) GetLogs(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, scheduledAt time.Time,
+	instanceName string) (models.InstanceSpec, []byte, error) {
+	args := s.Called(ctx, namespace, jobSpec, scheduledAt, instanceName)
+	return args.Get(0).(models.InstanceSpec), args.Get(1).([]byte), args.Error(2)
+}
+
 func (s *RunService) Compile(ctx context.Context, namespaceSpec models.NamespaceSpec, jobRun models.JobRun, instanceSpec models.InstanceSpec) (envMap map[string]string, fileMap map[string]string, err error) {
 	args := s.Called(ctx, namespaceSpec, jobRun, instanceSpec)
 	return args.Get(0).(map[string]string), args.Get(1).(map[string]string), args.Error(2)
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -92,6 +93,10 @@ func (repo *JobSpecRepository) Delete(ctx context.Context, name string) error {
 	return repo.Called(ctx, name).Error(0)
 }
 
+func (repo *JobSpecRepository) SetPaused(ctx context.Context, name string, paused bool) error {
+	return repo.Called(ctx, name, paused).Error(0)
+}
+
 func (repo *JobSpecRepository) GetAll(ctx context.Context) ([]models.JobSpec, error) {
 	args := repo.Called(ctx)
 	if args.Get(0) != nil {
@@ -181,6 +186,11 @@ func (j *JobService) GetReplayList(ctx context.Context, projectUUID uuid.UUID) (
 	return args.Get(0).([]models.ReplaySpec), args.Error(1)
 }
 
+func (j *JobService) CancelReplay(ctx context.Context, replayRequest models.ReplayRequest, failRuns bool) error {
+	args := j.Called(ctx, replayRequest, failRuns)
+	return args.Error(0)
+}
+
 func (j *JobService) Run(ctx context.Context, ns models.NamespaceSpec, js []models.JobSpec, obs progress.Observer) error {
 	args := j.Called(ctx, ns, js, obs)
 	return args.Error(0)
@@ -196,6 +206,28 @@ func (j *JobService) GetDownstream(ctx context.Context, projectSpec models.Proje
 	return args.Get(0).([]models.JobSpec), args.Error(1)
 }
 
+func (j *JobService) Pause(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
+	return j.Called(ctx, namespace, jobSpec).Error(0)
+}
+
+func (j *JobService) Resume(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
+	return j.Called(ctx, namespace, jobSpec).Error(0)
+}
+
+func (j *JobService) GetDrift(ctx context.Context, namespace models.NamespaceSpec, repair bool) (models.JobDrift, error) {
+	args := j.Called(ctx, namespace, repair)
+	return args.Get(0).(models.JobDrift), args.Error(1)
+}
+
+func (j *JobService) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, scheduledAt time.Time,
+	config map[string]string) error {
+	return j.Called(ctx, namespace, jobSpec, scheduledAt, config).Error(0)
+}
+
+func (j *JobService) CheckJobTemplate(ctx context.Context, projectSpec models.ProjectSpec, template []byte) error {
+	return j.Called(ctx, projectSpec, template).Error(0)
+}
+
 type DependencyResolver struct {
 	mock.Mock
 }
@@ -27,6 +27,10 @@ func (pr *ProjectRepository) GetAll(ctx context.Context) ([]models.ProjectSpec,
 	return args.Get(0).([]models.ProjectSpec), args.Error(1)
 }
 
+func (pr *ProjectRepository) SetJobTemplate(ctx context.Context, name string, template []byte) error {
+	return pr.Called(ctx, name, template).Error(0)
+}
+
 type ProjectRepoFactory struct {
 	mock.Mock
 }
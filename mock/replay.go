@@ -39,6 +39,16 @@ func (repo *ReplayRepository) UpdateStatus(ctx context.Context, replayID uuid.UU
 	return repo.Called(ctx, replayID, status, message).Error(0)
 }
 
+func (repo *ReplayRepository) UpdateStatusFrom(ctx context.Context, replayID uuid.UUID, from []string, status string,
+	message models.ReplayMessage) (bool, error) {
+	args := repo.Called(ctx, replayID, from, status, message)
+	return args.Bool(0), args.Error(1)
+}
+
+func (repo *ReplayRepository) UpdateProgress(ctx context.Context, replayID uuid.UUID, progress models.ReplayProgress) error {
+	return repo.Called(ctx, replayID, progress).Error(0)
+}
+
 func (repo *ReplayRepository) GetByStatus(ctx context.Context, status []string) ([]models.ReplaySpec, error) {
 	args := repo.Called(ctx, status)
 	return args.Get(0).([]models.ReplaySpec), args.Error(1)
@@ -97,6 +107,10 @@ func (rm *ReplayManager) GetRunStatus(ctx context.Context, projectSpec models.Pr
 	return args.Get(0).([]models.JobStatus), args.Error(1)
 }
 
+func (rm *ReplayManager) Cancel(ctx context.Context, projectSpec models.ProjectSpec, replayID uuid.UUID, failRuns bool) error {
+	return rm.Called(ctx, projectSpec, replayID, failRuns).Error(0)
+}
+
 type ReplayWorkerFactoryIndexed struct {
 	mock.Mock
 	Workers []interface{}
@@ -160,3 +174,16 @@ func (rs *ReplaySyncer) Sync(context context.Context, runTimeout time.Duration)
 	args := rs.Called(context, runTimeout)
 	return args.Error(0)
 }
+
+type ReplayLocker struct {
+	mock.Mock
+}
+
+func (rl *ReplayLocker) TryLock(ctx context.Context, replayID uuid.UUID) (bool, error) {
+	args := rl.Called(ctx, replayID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (rl *ReplayLocker) Unlock(ctx context.Context, replayID uuid.UUID) error {
+	return rl.Called(ctx, replayID).Error(0)
+}
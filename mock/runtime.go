@@ -41,3 +41,37 @@ func (r *RuntimeService_DeployJobSpecificationServer) SendMsg(m interface{}) err
 func (r *RuntimeService_DeployJobSpecificationServer) RecvMsg(m interface{}) error {
 	panic("implement me")
 }
+
+type RuntimeService_GetInstanceLogsServer struct {
+	mock.Mock
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) Send(response *pb.GetInstanceLogsResponse) error {
+	args := r.Called(response)
+	return args.Error(0)
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) SetHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) SendHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) SetTrailer(md metadata.MD) {
+	panic("implement me")
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) Context() context.Context {
+	args := r.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) SendMsg(m interface{}) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_GetInstanceLogsServer) RecvMsg(m interface{}) error {
+	panic("implement me")
+}
@@ -2,8 +2,12 @@ package mock
 
 import (
 	"context"
+	"io"
 	"time"
 
+	"github.com/hashicorp/serf/serf"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus/cluster"
+	"github.com/odpf/optimus/core/gossip"
 	"github.com/odpf/optimus/core/progress"
 
 	"github.com/odpf/optimus/models"
@@ -38,6 +42,10 @@ func (ms *Scheduler) GetName() string {
 	return "mocked"
 }
 
+func (ms *Scheduler) GetProjectTemplate(projectSpec models.ProjectSpec) []byte {
+	return ms.Called(projectSpec).Get(0).([]byte)
+}
+
 func (ms *Scheduler) Bootstrap(ctx context.Context, projectSpec models.ProjectSpec) error {
 	return ms.Called(ctx, projectSpec).Error(0)
 }
@@ -57,3 +65,87 @@ func (ms *Scheduler) GetJobRunStatus(ctx context.Context, projectSpec models.Pro
 	args := ms.Called(ctx, projectSpec, jobName, startDate, endDate, batchSize)
 	return args.Get(0).([]models.JobStatus), args.Error(1)
 }
+
+func (ms *Scheduler) CompileJob(ctx context.Context, namespace models.NamespaceSpec, job models.JobSpec) (models.Job, error) {
+	args := ms.Called(ctx, namespace, job)
+	return args.Get(0).(models.Job), args.Error(1)
+}
+
+func (ms *Scheduler) Pause(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return ms.Called(ctx, namespace, jobName).Error(0)
+}
+
+func (ms *Scheduler) Resume(ctx context.Context, namespace models.NamespaceSpec, jobName string) error {
+	return ms.Called(ctx, namespace, jobName).Error(0)
+}
+
+func (ms *Scheduler) GetJobRunDetails(ctx context.Context, projectSpec models.ProjectSpec, jobName string,
+	scheduledAt time.Time) (models.JobRunDetails, error) {
+	args := ms.Called(ctx, projectSpec, jobName, scheduledAt)
+	return args.Get(0).(models.JobRunDetails), args.Error(1)
+}
+
+func (ms *Scheduler) TriggerRun(ctx context.Context, namespace models.NamespaceSpec, jobName string, scheduledAt time.Time,
+	config map[string]string) error {
+	return ms.Called(ctx, namespace, jobName, scheduledAt, config).Error(0)
+}
+
+func (ms *Scheduler) SyncSecret(ctx context.Context, projectSpec models.ProjectSpec, secret models.ProjectSecretItem) error {
+	return ms.Called(ctx, projectSpec, secret).Error(0)
+}
+
+func (ms *Scheduler) CancelRuns(ctx context.Context, projectSpec models.ProjectSpec, jobName string, startDate, endDate time.Time) error {
+	return ms.Called(ctx, projectSpec, jobName, startDate, endDate).Error(0)
+}
+
+type Executor struct {
+	mock.Mock
+}
+
+func (e *Executor) Start(ctx context.Context, req models.ExecutorStartRequest) (*models.ExecutorStartResponse, error) {
+	args := e.Called(ctx, req)
+	return args.Get(0).(*models.ExecutorStartResponse), args.Error(1)
+}
+
+func (e *Executor) Stop(ctx context.Context, req models.ExecutorStopRequest) error {
+	return e.Called(ctx, req).Error(0)
+}
+
+func (e *Executor) WaitForFinish(ctx context.Context, id string) (chan int, error) {
+	args := e.Called(ctx, id)
+	return args.Get(0).(chan int), args.Error(1)
+}
+
+func (e *Executor) Stats(ctx context.Context, id string) (*models.ExecutorStats, error) {
+	args := e.Called(ctx, id)
+	return args.Get(0).(*models.ExecutorStats), args.Error(1)
+}
+
+func (e *Executor) Logs(ctx context.Context, id string) (io.ReadCloser, error) {
+	args := e.Called(ctx, id)
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+type ClusterManager struct {
+	mock.Mock
+}
+
+func (c *ClusterManager) IsLeader() bool {
+	return c.Called().Bool(0)
+}
+
+func (c *ClusterManager) ApplyCommand(cmd *pb.CommandLog) error {
+	return c.Called(cmd).Error(0)
+}
+
+func (c *ClusterManager) GetState() gossip.State {
+	return c.Called().Get(0).(gossip.State)
+}
+
+func (c *ClusterManager) GetClusterMembers() []serf.Member {
+	return c.Called().Get(0).([]serf.Member)
+}
+
+func (c *ClusterManager) GetLocalMember() serf.Member {
+	return c.Called().Get(0).(serf.Member)
+}
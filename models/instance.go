@@ -30,6 +30,9 @@ const (
 	// InstanceType is the kind of execution happening at the time
 	InstanceTypeTask InstanceType = "task"
 	InstanceTypeHook InstanceType = "hook"
+	// InstanceTypeSensor waits for upstream dependencies of a job, these are
+	// only reported by schedulers and never registered as instances
+	InstanceTypeSensor InstanceType = "sensor"
 
 	// job run created by a batch schedule
 	TriggerSchedule JobRunTrigger = "schedule"
@@ -49,12 +52,14 @@ var (
 	RunStatePending JobRunState = "pending"
 
 	// non assignment, non terminating states
-	RunStateAccepted JobRunState = "accepted"
-	RunStateRunning  JobRunState = "running"
+	RunStateAccepted   JobRunState = "accepted"
+	RunStateRunning    JobRunState = "running"
+	RunStateCancelling JobRunState = "cancelling"
 
 	// terminate states
-	RunStateSuccess JobRunState = "success"
-	RunStateFailed  JobRunState = "failed"
+	RunStateSuccess   JobRunState = "success"
+	RunStateFailed    JobRunState = "failed"
+	RunStateCancelled JobRunState = "cancelled"
 )
 
 type JobRunState string
@@ -112,6 +117,10 @@ type InstanceSpec struct {
 	Status JobRunState
 	Data   []InstanceSpecData
 
+	// Attempt is the execution attempt of this instance in a job run
+	// starting from 1, it increases every time the instance is retried
+	Attempt int
+
 	ExecutedAt time.Time
 	UpdatedAt  time.Time
 }
@@ -139,6 +148,25 @@ type RunService interface {
 	// Register creates a new instance in provided job run
 	Register(ctx context.Context, namespace NamespaceSpec, jobRun JobRun, instanceType InstanceType, instanceName string) (InstanceSpec, error)
 
+	// PrepInstance prepares an instance of the job run along with the data
+	// it needs for execution without saving it
+	PrepInstance(ctx context.Context, jobRun JobRun, instanceType InstanceType, instanceName string) (InstanceSpec, error)
+
+	// UpdateInstanceStatus sets status of an instance in the run of a job
+	// scheduled at provided time, used by schedulers which report
+	// completion of instances back to optimus
+	UpdateInstanceStatus(ctx context.Context, namespace NamespaceSpec, jobSpec JobSpec, scheduledAt time.Time,
+		instanceType InstanceType, instanceName string, status JobRunState) error
+
+	// Cancel requests the run of a job scheduled at provided time to be
+	// stopped, returns the run with its updated state
+	Cancel(ctx context.Context, namespace NamespaceSpec, jobSpec JobSpec, scheduledAt time.Time) (JobRun, error)
+
+	// GetLogs returns output of the latest attempt of an instance in the run
+	// of a job scheduled at provided time, instance defaults to task of job
+	GetLogs(ctx context.Context, namespace NamespaceSpec, jobSpec JobSpec, scheduledAt time.Time,
+		instanceName string) (InstanceSpec, []byte, error)
+
 	// Compile prepares instance execution context environment
 	Compile(ctx context.Context, namespaceSpec NamespaceSpec, jobRun JobRun, instanceSpec InstanceSpec) (envMap map[string]string,
 		fileMap map[string]string, err error)
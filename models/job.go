@@ -41,6 +41,7 @@ const (
 
 	JobEventTypeSLAMiss JobEventType = "sla_miss"
 	JobEventTypeFailure JobEventType = "failure"
+	JobEventTypeSuccess JobEventType = "success"
 )
 
 // JobSpec represents a job
@@ -58,6 +59,9 @@ type JobSpec struct {
 	Dependencies map[string]JobSpecDependency // job name to dependency
 	Assets       JobAssets
 	Hooks        []JobSpecHook
+
+	// Paused jobs stay deployed but are not scheduled till resumed
+	Paused bool
 }
 
 func (js JobSpec) GetName() string {
@@ -92,6 +96,8 @@ type JobSpecBehavior struct {
 	CatchUp       bool
 	Retry         JobSpecBehaviorRetry
 	Notify        []JobSpecNotifier
+	Resources     JobSpecResources
+	Execution     JobSpecExecution
 }
 
 type JobSpecBehaviorRetry struct {
@@ -100,6 +106,32 @@ type JobSpecBehaviorRetry struct {
 	ExponentialBackoff bool
 }
 
+// JobSpecResources are compute resources of the containers executing the
+// job, values are kubernetes quantities like 500m or 1Gi
+type JobSpecResources struct {
+	Request JobSpecResourceConfig
+	Limit   JobSpecResourceConfig
+}
+
+type JobSpecResourceConfig struct {
+	CPU    string
+	Memory string
+}
+
+// IsEmpty is true when no resource is configured, scheduler defaults are
+// used in that case
+func (r JobSpecResources) IsEmpty() bool {
+	return r.Request == JobSpecResourceConfig{} && r.Limit == JobSpecResourceConfig{}
+}
+
+// JobSpecExecution controls where and for how long scheduler executes
+// the tasks of job
+type JobSpecExecution struct {
+	Pool    string
+	Queue   string
+	Timeout time.Duration
+}
+
 type JobSpecNotifier struct {
 	On       JobEventType
 	Config   map[string]string
@@ -322,10 +354,26 @@ type JobService interface {
 	GetReplayStatus(context.Context, ReplayRequest) (ReplayState, error)
 	//GetReplayList of a project
 	GetReplayList(ctx context.Context, projectID uuid.UUID) ([]ReplaySpec, error)
+	// CancelReplay stops an in-flight replay, unfinished runs cleared by the
+	// replay are marked failed if failRuns is set
+	CancelReplay(ctx context.Context, replayRequest ReplayRequest, failRuns bool) error
 	// GetByDestination fetches a Job by destination for a specific project
 	GetByDestination(ctx context.Context, projectSpec ProjectSpec, destination string) (JobSpec, error)
 	// GetDownstream fetches downstream jobspecs
 	GetDownstream(ctx context.Context, projectSpec ProjectSpec, jobName string) ([]JobSpec, error)
+	// Pause stops scheduling new runs of the job till it is resumed
+	Pause(context.Context, NamespaceSpec, JobSpec) error
+	// Resume starts scheduling runs of a paused job again
+	Resume(context.Context, NamespaceSpec, JobSpec) error
+	// GetDrift compares jobs deployed on scheduler with the stored specs
+	// of a namespace, drift is repaired if asked to
+	GetDrift(ctx context.Context, namespace NamespaceSpec, repair bool) (JobDrift, error)
+	// TriggerRun asks batch scheduler to create an adhoc run of the job
+	TriggerRun(ctx context.Context, namespace NamespaceSpec, jobSpec JobSpec, scheduledAt time.Time,
+		config map[string]string) error
+	// CheckJobTemplate verifies a custom job template of the project by
+	// compiling a sample of its jobs with it
+	CheckJobTemplate(ctx context.Context, projectSpec ProjectSpec, template []byte) error
 }
 
 // JobCompiler takes template file of a scheduler and after applying
@@ -341,8 +389,35 @@ type Job struct {
 	Contents []byte
 }
 
+// JobDrift is the difference between jobs stored in optimus and the
+// jobs deployed on scheduler for a namespace
+type JobDrift struct {
+	// Orphaned jobs are deployed on scheduler but not stored in optimus
+	Orphaned []string
+	// Missing jobs are stored in optimus but not deployed on scheduler
+	Missing []string
+	// Modified jobs are deployed with contents which differ from what
+	// their specs compile to now
+	Modified []string
+	// Repaired is true if the drift was fixed by deploying and deleting jobs
+	Repaired bool
+}
+
+// HasDrift returns true if scheduler is out of sync with optimus
+func (d JobDrift) HasDrift() bool {
+	return len(d.Orphaned) > 0 || len(d.Missing) > 0 || len(d.Modified) > 0
+}
+
 type JobEventType string
 
+const (
+	// keys of job event values identifying the instance an event is
+	// reported for
+	JobEventKeyScheduledAt  = "scheduled_at"
+	JobEventKeyInstanceType = "instance_type"
+	JobEventKeyInstanceName = "instance_name"
+)
+
 // JobEvent refers to status updates related to job
 // posted by scheduler
 type JobEvent struct {
@@ -2,6 +2,12 @@ package models
 
 import "github.com/google/uuid"
 
+const (
+	// NamespaceConcurrencyKey caps the number of job runs of a namespace
+	// which can be executed at a time by schedulers that support it
+	NamespaceConcurrencyKey = "CONCURRENCY"
+)
+
 // NamespaceSpec represents a namespace which is an individual or a team with an unique name.
 // A Project can have any number of namespaces (with unique names).
 type NamespaceSpec struct {
@@ -9,6 +15,8 @@ type NamespaceSpec struct {
 
 	Name string
 
+	// configuration for the namespace
+	// - NamespaceConcurrencyKey: optional limit on concurrent job runs
 	Config map[string]string
 
 	// ProjectSpec is the project that this namespace belongs to
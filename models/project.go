@@ -51,6 +51,10 @@ type ProjectSpec struct {
 	// Name: <plugintype>_<plugin_name>, Value: <base64encodedstring>
 	// For example: TASK_BQ2BQ: secret_as_base64
 	Secret ProjectSecrets
+
+	// JobTemplate if set is used to compile jobs of the project instead of
+	// the default template of batch scheduler
+	JobTemplate []byte
 }
 
 func (s ProjectSpec) String() string {
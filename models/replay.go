@@ -33,6 +33,99 @@ type ReplayRequest struct {
 	Project    ProjectSpec
 	JobSpecMap map[string]JobSpec
 	Force      bool
+	Filter     ReplayFilter
+
+	// FailedRunsOnly replays only the runs which failed in scheduler,
+	// FailedRunsDownstream replays downstream runs of them as well
+	FailedRunsOnly       bool
+	FailedRunsDownstream bool
+
+	// JobNamespaceMap holds namespace name of jobs belonging to namespaces
+	// used by the filter
+	JobNamespaceMap map[string]string
+}
+
+// ReplayFilter selects downstream jobs replayed along with the requested
+// job, an excluded job is skipped along with its downstream while runs of a
+// job which isn't included are skipped but its downstream is still replayed
+type ReplayFilter struct {
+	// RootOnly replays only the requested job
+	RootOnly bool
+	// MaxDepth limits levels of downstream replayed, 0 replays all of them
+	MaxDepth int
+
+	IncludeJobs       []string
+	ExcludeJobs       []string
+	IncludeNamespaces []NamespaceSpec
+	ExcludeNamespaces []NamespaceSpec
+	// IncludeLabels selects jobs having all of these labels
+	IncludeLabels map[string]string
+	// ExcludeLabels skips jobs having any of these labels
+	ExcludeLabels map[string]string
+}
+
+// IsEmpty returns true if the filter selects every downstream job
+func (f ReplayFilter) IsEmpty() bool {
+	return !f.RootOnly && f.MaxDepth == 0 && len(f.IncludeJobs) == 0 && len(f.ExcludeJobs) == 0 &&
+		len(f.IncludeNamespaces) == 0 && len(f.ExcludeNamespaces) == 0 &&
+		len(f.IncludeLabels) == 0 && len(f.ExcludeLabels) == 0
+}
+
+// HasIncludes returns true if the filter has any include criteria
+func (f ReplayFilter) HasIncludes() bool {
+	return len(f.IncludeJobs) > 0 || len(f.IncludeNamespaces) > 0 || len(f.IncludeLabels) > 0
+}
+
+// Includes checks if a downstream job matches every include criteria of the
+// filter, jobs not included are only traversed to reach their downstream
+func (f ReplayFilter) Includes(jobSpec JobSpec, namespace string) bool {
+	if len(f.IncludeJobs) > 0 && !containsString(f.IncludeJobs, jobSpec.Name) {
+		return false
+	}
+	if len(f.IncludeNamespaces) > 0 && !containsNamespace(f.IncludeNamespaces, namespace) {
+		return false
+	}
+	for key, value := range f.IncludeLabels {
+		if jobValue, ok := jobSpec.Labels[key]; !ok || jobValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Excludes checks if a downstream job matches any exclude criteria of the
+// filter, excluded jobs are skipped along with their downstream
+func (f ReplayFilter) Excludes(jobSpec JobSpec, namespace string) bool {
+	if containsString(f.ExcludeJobs, jobSpec.Name) {
+		return true
+	}
+	if containsNamespace(f.ExcludeNamespaces, namespace) {
+		return true
+	}
+	for key, value := range f.ExcludeLabels {
+		if jobValue, ok := jobSpec.Labels[key]; ok && jobValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNamespace(namespaces []NamespaceSpec, name string) bool {
+	for _, namespace := range namespaces {
+		if namespace.Name == name {
+			return true
+		}
+	}
+	return false
 }
 
 type ReplaySpec struct {
@@ -43,9 +136,22 @@ type ReplaySpec struct {
 	ExecutionTree *tree.TreeNode
 	Status        string
 	Message       ReplayMessage
+	Progress      ReplayProgress
 	CreatedAt     time.Time
 }
 
+// ReplayProgress tracks replays whose runs are cleared in batches, next
+// batch is cleared only after runs of the previous one are finished
+type ReplayProgress struct {
+	ClearedBatches int
+	TotalBatches   int
+}
+
+// IsCleared reports if runs of every batch are cleared
+func (p ReplayProgress) IsCleared() bool {
+	return p.ClearedBatches >= p.TotalBatches
+}
+
 type ReplayState struct {
 	Status string
 	Node   *tree.TreeNode
@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/odpf/optimus/core/progress"
@@ -42,6 +43,56 @@ type SchedulerUnit interface {
 	// GetJobRunStatus should return batch of runs of a job
 	GetJobRunStatus(ctx context.Context, projectSpec ProjectSpec, jobName string, startDate time.Time,
 		endDate time.Time, batchSize int) ([]JobStatus, error)
+
+	// Pause stops scheduler from creating new runs of the job, runs
+	// under execution are not affected
+	Pause(ctx context.Context, namespace NamespaceSpec, jobName string) error
+
+	// Resume allows scheduler to create runs of a paused job again
+	Resume(ctx context.Context, namespace NamespaceSpec, jobName string) error
+
+	// TriggerRun creates an adhoc run of the job for provided scheduled time,
+	// config is passed to the run as its configuration
+	TriggerRun(ctx context.Context, namespace NamespaceSpec, jobName string, scheduledAt time.Time,
+		config map[string]string) error
+}
+
+// JobCompilingScheduler is implemented by schedulers which compile job
+// specs before deploying them, contents of deployed jobs can only be
+// verified for such schedulers
+type JobCompilingScheduler interface {
+	// CompileJob compiles the job spec same as it is compiled for deployment
+	CompileJob(ctx context.Context, namespace NamespaceSpec, job JobSpec) (Job, error)
+}
+
+// JobRunDetailsScheduler is implemented by schedulers which can report
+// execution details of every task, hook and sensor of a job run
+type JobRunDetailsScheduler interface {
+	GetJobRunDetails(ctx context.Context, projectSpec ProjectSpec, jobName string, scheduledAt time.Time) (JobRunDetails, error)
+}
+
+// CustomTemplateScheduler is implemented by schedulers which let projects
+// replace their default job template
+type CustomTemplateScheduler interface {
+	// GetProjectTemplate returns the job template used to compile jobs of
+	// the project
+	GetProjectTemplate(projectSpec ProjectSpec) []byte
+}
+
+// SecretSyncingScheduler is implemented by schedulers which keep selected
+// project secrets in sync with their own credential store
+type SecretSyncingScheduler interface {
+	// SyncSecret pushes the secret to scheduler if the project has selected
+	// it to be synced, other secrets are ignored
+	SyncSecret(ctx context.Context, projectSpec ProjectSpec, secret ProjectSecretItem) error
+}
+
+// RunCancellingScheduler is implemented by schedulers which can stop job
+// runs that are still queued or running
+type RunCancellingScheduler interface {
+	// CancelRuns marks unfinished runs of the job scheduled between start
+	// and end date as failed
+	CancelRuns(ctx context.Context, projectSpec ProjectSpec, jobName string, startDate, endDate time.Time) error
 }
 
 type SchedulerListOptions struct {
@@ -53,6 +104,26 @@ type JobStatus struct {
 	State       JobRunState
 }
 
+// JobRunDetails is the state of a job run along with its instances
+type JobRunDetails struct {
+	ScheduledAt time.Time
+	State       JobRunState
+	Instances   []InstanceDetails
+}
+
+// InstanceDetails is the execution state of a task, hook or sensor of a run
+type InstanceDetails struct {
+	Name      string
+	Type      InstanceType
+	State     JobRunState
+	TryNumber int
+
+	// StartedAt and EndedAt are zero till the instance starts or ends
+	StartedAt time.Time
+	EndedAt   time.Time
+	Duration  time.Duration
+}
+
 // progress events
 type (
 	// EventJobSpecCompile represents a specification
@@ -64,8 +135,15 @@ type (
 	EventJobUpload struct {
 		Name string
 		Err  error
+
+		// Skipped is true if the job was already uploaded as is
+		Skipped bool
 	}
 
+	// EventJobUploadSkipped represents the number of compiled
+	// Jobs which were not uploaded because those are unchanged
+	EventJobUploadSkipped struct{ Count int }
+
 	// EventJobRemoteDelete signifies that a
 	// compiled job from a remote repository is being deleted
 	EventJobRemoteDelete struct{ Name string }
@@ -79,9 +157,16 @@ func (e *EventJobUpload) String() string {
 	if e.Err != nil {
 		return fmt.Sprintf("uploading: %s, failed with error): %s", e.Name, e.Err.Error())
 	}
+	if e.Skipped {
+		return fmt.Sprintf("skipped: %s, unchanged", e.Name)
+	}
 	return fmt.Sprintf("uploaded: %s", e.Name)
 }
 
+func (e *EventJobUploadSkipped) String() string {
+	return fmt.Sprintf("skipped uploading %d unchanged jobs", e.Count)
+}
+
 func (e *EventJobRemoteDelete) String() string {
 	return fmt.Sprintf("deleting: %s", e.Name)
 }
@@ -100,6 +185,10 @@ type ExecutorUnit interface {
 
 	// Stats provides current statistics of the running/finished instance
 	Stats(ctx context.Context, id string) (*ExecutorStats, error)
+
+	// Logs returns a stream of the instance output from the beginning, stream
+	// keeps blocking for more output till the instance finishes
+	Logs(ctx context.Context, id string) (io.ReadCloser, error)
 }
 
 type ExecutorStartRequest struct {
@@ -108,6 +197,11 @@ type ExecutorStartRequest struct {
 
 	Job       JobSpec
 	Namespace NamespaceSpec
+
+	// JobRun and Instance identify which task or hook of the job
+	// needs to be executed
+	JobRun   JobRun
+	Instance InstanceSpec
 }
 
 type ExecutorStopRequest struct {
@@ -121,4 +215,11 @@ type ExecutorStartResponse struct {
 type ExecutorStats struct {
 	Logs   []byte
 	Status string
+
+	// ExitCode is only valid once the execution is finished
+	ExitCode int
+
+	// UserTime and SystemTime are cpu time consumed by the execution
+	UserTime   time.Duration
+	SystemTime time.Duration
 }
@@ -24,6 +24,15 @@ const (
 	ConfigKeyExecutionTime = "EXECUTION_TIME"
 	// ConfigKeyDestination is destination urn
 	ConfigKeyDestination = "JOB_DESTINATION"
+
+	// cancelAttempts is how many times cancellation is retried if status of
+	// the run changes while it is being cancelled
+	cancelAttempts = 3
+)
+
+var (
+	// ErrRunNotCancellable is returned when a run has already finished
+	ErrRunNotCancellable = errors.New("run is not in a cancellable state")
 )
 
 type SpecRepoFactory interface {
@@ -32,6 +41,7 @@ type SpecRepoFactory interface {
 
 type Service struct {
 	repoFac        SpecRepoFactory
+	logRepo        store.InstanceLogRepository
 	Now            func() time.Time
 	templateEngine models.TemplateEngine
 }
@@ -72,16 +82,7 @@ func (s *Service) GetScheduledRun(ctx context.Context, namespace models.Namespac
 
 func (s *Service) Register(ctx context.Context, namespace models.NamespaceSpec, jobRun models.JobRun,
 	instanceType models.InstanceType, instanceName string) (models.InstanceSpec, error) {
-	executedAt := s.Now()
-	if len(jobRun.Instances) > 0 {
-		// Extract execution time which will be shared across all job run instances.
-		// Note(kushsharma): This is not the best way to do this, a better inter task communication
-		// mechanism should be in place at job run level which each instance can use
-		// at least as a key/value map for temporary storage. This kv map will be
-		// available to all the instances
-		executedAt = jobRun.Instances[0].ExecutedAt
-	}
-	instanceToSave, err := s.prepInstance(jobRun, instanceType, instanceName, executedAt)
+	instanceToSave, err := s.PrepInstance(ctx, jobRun, instanceType, instanceName)
 	if err != nil {
 		return models.InstanceSpec{}, errors.Wrap(err, "Register: failed to prepare instance")
 	}
@@ -122,11 +123,23 @@ func (s *Service) Register(ctx context.Context, namespace models.NamespaceSpec,
 	return jobRun.GetInstance(instanceName, instanceType)
 }
 
-func (s *Service) prepInstance(jobRun models.JobRun, instanceType models.InstanceType,
-	instanceName string, executedAt time.Time) (models.InstanceSpec, error) {
+// PrepInstance prepares an instance of the job run along with the data it
+// needs for execution, instance is not saved
+func (s *Service) PrepInstance(ctx context.Context, jobRun models.JobRun, instanceType models.InstanceType,
+	instanceName string) (models.InstanceSpec, error) {
+	executedAt := s.Now()
+	if len(jobRun.Instances) > 0 {
+		// Extract execution time which will be shared across all job run instances.
+		// Note(kushsharma): This is not the best way to do this, a better inter task communication
+		// mechanism should be in place at job run level which each instance can use
+		// at least as a key/value map for temporary storage. This kv map will be
+		// available to all the instances
+		executedAt = jobRun.Instances[0].ExecutedAt
+	}
+
 	var jobDestination string
 	if jobRun.Spec.Task.Unit.DependencyMod != nil {
-		jobDestinationResponse, err := jobRun.Spec.Task.Unit.DependencyMod.GenerateDestination(context.TODO(), models.GenerateDestinationRequest{
+		jobDestinationResponse, err := jobRun.Spec.Task.Unit.DependencyMod.GenerateDestination(ctx, models.GenerateDestinationRequest{
 			Config: models.PluginConfigs{}.FromJobSpec(jobRun.Spec.Task.Config),
 			Assets: models.PluginAssets{}.FromJobSpec(jobRun.Spec.Assets),
 		})
@@ -167,13 +180,100 @@ func (s *Service) prepInstance(jobRun models.JobRun, instanceType models.Instanc
 	}, nil
 }
 
+// UpdateInstanceStatus sets status of the latest attempt of an instance in
+// the run of a job scheduled at provided time
+func (s *Service) UpdateInstanceStatus(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec,
+	scheduledAt time.Time, instanceType models.InstanceType, instanceName string, status models.JobRunState) error {
+	repo := s.repoFac.New()
+	jobRun, _, err := repo.GetByScheduledAt(ctx, jobSpec.ID, scheduledAt)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find run of %s scheduled at %s", jobSpec.Name, scheduledAt)
+	}
+	instance, err := jobRun.GetInstance(instanceName, instanceType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find %s %s in run of %s", instanceType, instanceName, jobSpec.Name)
+	}
+	instance.Status = status
+	return repo.AddInstance(ctx, namespace, jobRun, instance)
+}
+
+// Cancel stops the run of a job scheduled at provided time. Pending runs are
+// cancelled right away, runs already picked by scheduler are marked to be
+// stopped by the peer executing them. Status is updated only if the run is
+// still in the state it was read in, else the run is read again
+func (s *Service) Cancel(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec,
+	scheduledAt time.Time) (models.JobRun, error) {
+	repo := s.repoFac.New()
+	for attempt := 0; attempt < cancelAttempts; attempt++ {
+		jobRun, _, err := repo.GetByScheduledAt(ctx, jobSpec.ID, scheduledAt)
+		if err != nil {
+			return models.JobRun{}, err
+		}
+
+		currentStatus := jobRun.Status
+		switch currentStatus {
+		case models.RunStatePending:
+			jobRun.Status = models.RunStateCancelled
+		case models.RunStateAccepted, models.RunStateRunning:
+			jobRun.Status = models.RunStateCancelling
+		default:
+			return models.JobRun{}, errors.Wrapf(ErrRunNotCancellable, "run of %s is %s", jobSpec.Name, jobRun.Status)
+		}
+		updated, err := repo.UpdateStatusFrom(ctx, jobRun.ID, currentStatus, jobRun.Status)
+		if err != nil {
+			return models.JobRun{}, errors.Wrapf(err, "failed to cancel run of %s", jobSpec.Name)
+		}
+		if updated {
+			return jobRun, nil
+		}
+	}
+	return models.JobRun{}, errors.Errorf("failed to cancel run of %s, its status kept changing", jobSpec.Name)
+}
+
+// GetLogs finds output of the latest attempt of an instance in the run of a
+// job scheduled at provided time. Instance is the task of job if name is not
+// provided. Logs are empty if instance hasn't produced any output so far
+func (s *Service) GetLogs(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec,
+	scheduledAt time.Time, instanceName string) (models.InstanceSpec, []byte, error) {
+	if s.logRepo == nil {
+		return models.InstanceSpec{}, nil, errors.New("instance logs are not persisted")
+	}
+	jobRun, _, err := s.repoFac.New().GetByScheduledAt(ctx, jobSpec.ID, scheduledAt)
+	if err != nil {
+		return models.InstanceSpec{}, nil, err
+	}
+
+	if instanceName == "" {
+		instanceName = jobSpec.Task.Unit.Info().Name
+	}
+	var instance models.InstanceSpec
+	found := false
+	for _, runInstance := range jobRun.Instances {
+		if runInstance.Name == instanceName && (!found || runInstance.Attempt > instance.Attempt) {
+			instance = runInstance
+			found = true
+		}
+	}
+	if !found {
+		return models.InstanceSpec{}, nil, errors.Wrapf(store.ErrResourceNotFound, "instance %s of job %s", instanceName, jobSpec.Name)
+	}
+
+	logs, err := s.logRepo.GetByInstanceID(ctx, instance.ID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return models.InstanceSpec{}, nil, err
+	}
+	return instance, logs, nil
+}
+
 func (s *Service) GetByID(ctx context.Context, JobRunID uuid.UUID) (models.JobRun, models.NamespaceSpec, error) {
 	return s.repoFac.New().GetByID(ctx, JobRunID)
 }
 
-func NewService(repoFac SpecRepoFactory, timeFunc func() time.Time, te models.TemplateEngine) *Service {
+func NewService(repoFac SpecRepoFactory, timeFunc func() time.Time, te models.TemplateEngine,
+	logRepo store.InstanceLogRepository) *Service {
 	return &Service{
 		repoFac:        repoFac,
+		logRepo:        logRepo,
 		Now:            timeFunc,
 		templateEngine: te,
 	}
@@ -109,7 +109,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, jobRun, models.InstanceTypeTask, "bq")
 			assert.Nil(t, err)
 			assert.Equal(t, instanceSpec, returnedInstanceSpec)
@@ -155,7 +155,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, jobRun, instanceSpec.Type, instanceSpec.Name)
 			assert.Nil(t, err)
@@ -201,7 +201,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, localRun, instanceSpec.Type, instanceSpec.Name)
 			assert.Nil(t, err)
@@ -247,7 +247,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, time.Now().UTC, nil)
+			runService := run.NewService(jobRunSpecRep, time.Now().UTC, nil, nil)
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, localRun, instanceSpec.Type, instanceSpec.Name)
 			assert.Nil(t, err)
 			assert.Equal(t, returnedInstanceSpec, instanceSpec)
@@ -291,7 +291,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 
 			returnedInstanceSpec, err := runService.Register(ctx, namespaceSpec, jobRun, instanceSpec.Type, instanceSpec.Name)
 			assert.Equal(t, "a random error", err.Error())
@@ -315,7 +315,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 			returnedSpec, err := runService.GetScheduledRun(ctx, namespaceSpec, jobSpec, scheduledAt)
 			assert.Nil(t, err)
 			assert.Equal(t, jobRun, returnedSpec)
@@ -335,7 +335,7 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 			_, _ = runService.GetScheduledRun(ctx, namespaceSpec, jobSpec, scheduledAt)
 		})
 		t.Run("should return empty RunSpec if GetByScheduledAt returns an error", func(t *testing.T) {
@@ -347,10 +347,170 @@ func TestService(t *testing.T) {
 			jobRunSpecRep.On("New").Return(runRepo, nil)
 			defer jobRunSpecRep.AssertExpectations(t)
 
-			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil)
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
 			returnedSpec, err := runService.GetScheduledRun(ctx, namespaceSpec, jobSpec, scheduledAt)
 			assert.Equal(t, "a random error", err.Error())
 			assert.Equal(t, models.JobRun{}, returnedSpec)
 		})
 	})
+	t.Run("Cancel", func(t *testing.T) {
+		t.Run("should cancel pending run right away", func(t *testing.T) {
+			pendingRun := jobRun
+			pendingRun.Status = models.RunStatePending
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(pendingRun, namespaceSpec, nil)
+			runRepo.On("UpdateStatusFrom", ctx, jobRun.ID, models.RunStatePending, models.RunStateCancelled).Return(true, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			cancelledRun, err := runService.Cancel(ctx, namespaceSpec, jobSpec, scheduledAt)
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateCancelled, cancelledRun.Status)
+		})
+		t.Run("should mark run in execution for cancellation", func(t *testing.T) {
+			runningRun := jobRun
+			runningRun.Status = models.RunStateRunning
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(runningRun, namespaceSpec, nil)
+			runRepo.On("UpdateStatusFrom", ctx, jobRun.ID, models.RunStateRunning, models.RunStateCancelling).Return(true, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			cancelledRun, err := runService.Cancel(ctx, namespaceSpec, jobSpec, scheduledAt)
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateCancelling, cancelledRun.Status)
+		})
+		t.Run("should read the run again if its status changed while cancelling", func(t *testing.T) {
+			pendingRun := jobRun
+			pendingRun.Status = models.RunStatePending
+			acceptedRun := jobRun
+			acceptedRun.Status = models.RunStateAccepted
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(pendingRun, namespaceSpec, nil).Once()
+			runRepo.On("UpdateStatusFrom", ctx, jobRun.ID, models.RunStatePending, models.RunStateCancelled).Return(false, nil)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(acceptedRun, namespaceSpec, nil).Once()
+			runRepo.On("UpdateStatusFrom", ctx, jobRun.ID, models.RunStateAccepted, models.RunStateCancelling).Return(true, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			cancelledRun, err := runService.Cancel(ctx, namespaceSpec, jobSpec, scheduledAt)
+			assert.Nil(t, err)
+			assert.Equal(t, models.RunStateCancelling, cancelledRun.Status)
+		})
+		t.Run("should fail if run has already finished", func(t *testing.T) {
+			finishedRun := jobRun
+			finishedRun.Status = models.RunStateSuccess
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(finishedRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			_, err := runService.Cancel(ctx, namespaceSpec, jobSpec, scheduledAt)
+			assert.True(t, errors.Is(err, run.ErrRunNotCancellable))
+		})
+	})
+	t.Run("UpdateInstanceStatus", func(t *testing.T) {
+		t.Run("should update status of the instance in run", func(t *testing.T) {
+			instance := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateRunning,
+				Attempt: 1,
+			}
+			runWithInstance := jobRun
+			runWithInstance.Instances = []models.InstanceSpec{instance}
+			finishedInstance := instance
+			finishedInstance.Status = models.RunStateSuccess
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(runWithInstance, namespaceSpec, nil)
+			runRepo.On("AddInstance", ctx, namespaceSpec, runWithInstance, finishedInstance).Return(nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			err := runService.UpdateInstanceStatus(ctx, namespaceSpec, jobSpec, scheduledAt, models.InstanceTypeTask, "bq", models.RunStateSuccess)
+			assert.Nil(t, err)
+		})
+		t.Run("should fail if instance is not registered in run", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(jobRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, nil)
+			err := runService.UpdateInstanceStatus(ctx, namespaceSpec, jobSpec, scheduledAt, models.InstanceTypeHook, "predator", models.RunStateFailed)
+			assert.Equal(t, "failed to find hook predator in run of foo: instance not found", err.Error())
+		})
+	})
+	t.Run("GetLogs", func(t *testing.T) {
+		t.Run("should return logs of the latest attempt of task", func(t *testing.T) {
+			firstAttempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateFailed,
+				Attempt: 1,
+			}
+			secondAttempt := models.InstanceSpec{
+				ID:      uuid.Must(uuid.NewRandom()),
+				Name:    "bq",
+				Type:    models.InstanceTypeTask,
+				Status:  models.RunStateRunning,
+				Attempt: 2,
+			}
+			retriedRun := jobRun
+			retriedRun.Instances = []models.InstanceSpec{secondAttempt, firstAttempt}
+
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(retriedRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			logRepo := new(mock.InstanceLogRepository)
+			logRepo.On("GetByInstanceID", ctx, secondAttempt.ID).Return([]byte("executing query\n"), nil)
+			defer logRepo.AssertExpectations(t)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, logRepo)
+			instance, logs, err := runService.GetLogs(ctx, namespaceSpec, jobSpec, scheduledAt, "")
+			assert.Nil(t, err)
+			assert.Equal(t, secondAttempt, instance)
+			assert.Equal(t, "executing query\n", string(logs))
+		})
+		t.Run("should fail if instance is not registered in run", func(t *testing.T) {
+			runRepo := new(mock.JobRunRepository)
+			runRepo.On("GetByScheduledAt", ctx, jobSpec.ID, scheduledAt).Return(jobRun, namespaceSpec, nil)
+			defer runRepo.AssertExpectations(t)
+
+			jobRunSpecRep := new(mock.JobRunRepoFactory)
+			jobRunSpecRep.On("New").Return(runRepo, nil)
+
+			runService := run.NewService(jobRunSpecRep, mockedTimeFunc, nil, new(mock.InstanceLogRepository))
+			_, _, err := runService.GetLogs(ctx, namespaceSpec, jobSpec, scheduledAt, "transporter")
+			assert.True(t, errors.Is(err, store.ErrResourceNotFound))
+		})
+	})
 }
@@ -0,0 +1,58 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	// InstanceLogDir is the directory in bucket which holds output of
+	// job run instances
+	InstanceLogDir = "logs"
+)
+
+// Bucket is a blob storage, local disk or any of the cloud storages
+// supported by gocloud
+type Bucket interface {
+	WriteAll(ctx context.Context, key string, p []byte, opts *blob.WriterOptions) error
+	ReadAll(ctx context.Context, key string) ([]byte, error)
+}
+
+type instanceLogRepository struct {
+	bucket Bucket
+}
+
+// Save overwrites logs of the instance, output is saved as a whole
+// every time it grows
+func (repo *instanceLogRepository) Save(ctx context.Context, instanceID uuid.UUID, logs []byte) error {
+	return repo.bucket.WriteAll(ctx, instanceLogKey(instanceID), logs, &blob.WriterOptions{
+		ContentType: "text/plain",
+	})
+}
+
+func (repo *instanceLogRepository) GetByInstanceID(ctx context.Context, instanceID uuid.UUID) ([]byte, error) {
+	logs, err := repo.bucket.ReadAll(ctx, instanceLogKey(instanceID))
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, store.ErrResourceNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to read logs of instance %s", instanceID)
+	}
+	return logs, nil
+}
+
+func instanceLogKey(instanceID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s.log", InstanceLogDir, instanceID)
+}
+
+func NewInstanceLogRepository(bucket Bucket) *instanceLogRepository {
+	return &instanceLogRepository{
+		bucket: bucket,
+	}
+}
@@ -0,0 +1,34 @@
+package bucket_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/store/bucket"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestInstanceLogRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should read the latest saved logs of instance", func(t *testing.T) {
+		instanceID := uuid.Must(uuid.NewRandom())
+		repo := bucket.NewInstanceLogRepository(memblob.OpenBucket(nil))
+
+		assert.Nil(t, repo.Save(ctx, instanceID, []byte("first\n")))
+		assert.Nil(t, repo.Save(ctx, instanceID, []byte("first\nsecond\n")))
+
+		logs, err := repo.GetByInstanceID(ctx, instanceID)
+		assert.Nil(t, err)
+		assert.Equal(t, "first\nsecond\n", string(logs))
+	})
+	t.Run("should return not found if instance has no logs", func(t *testing.T) {
+		repo := bucket.NewInstanceLogRepository(memblob.OpenBucket(nil))
+
+		_, err := repo.GetByInstanceID(ctx, uuid.Must(uuid.NewRandom()))
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
+}
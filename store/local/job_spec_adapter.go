@@ -55,6 +55,8 @@ type JobBehavior struct {
 	Catchup       bool             `yaml:"catch_up" json:"catch_up"`
 	Retry         JobBehaviorRetry `yaml:"retry,omitempty" json:"retry"`
 	Notify        []JobNotifier    `yaml:"notify,omitempty" json:"notify"`
+	Resources     JobResources     `yaml:"resources,omitempty" json:"resources"`
+	Execution     JobExecution     `yaml:"execution,omitempty" json:"execution"`
 }
 
 type JobBehaviorRetry struct {
@@ -63,6 +65,22 @@ type JobBehaviorRetry struct {
 	ExponentialBackoff bool   `yaml:"exponential_backoff,omitempty" json:"exponential_backoff,omitempty"`
 }
 
+type JobResources struct {
+	Request JobResourceConfig `yaml:"request,omitempty" json:"request,omitempty"`
+	Limit   JobResourceConfig `yaml:"limit,omitempty" json:"limit,omitempty"`
+}
+
+type JobResourceConfig struct {
+	CPU    string `yaml:"cpu,omitempty" json:"cpu,omitempty"`
+	Memory string `yaml:"memory,omitempty" json:"memory,omitempty"`
+}
+
+type JobExecution struct {
+	Pool    string `yaml:"pool,omitempty" json:"pool,omitempty"`
+	Queue   string `yaml:"queue,omitempty" json:"queue,omitempty"`
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
 type JobNotifier struct {
 	On       string `yaml:"on" json:"on" validate:"regexp=^(sla_miss|failure|)$"`
 	Config   map[string]string
@@ -140,6 +158,27 @@ func (conf *Job) MergeFrom(parent Job) {
 	if conf.Behavior.Catchup == false {
 		conf.Behavior.Catchup = parent.Behavior.Catchup
 	}
+	if conf.Behavior.Resources.Request.CPU == "" {
+		conf.Behavior.Resources.Request.CPU = parent.Behavior.Resources.Request.CPU
+	}
+	if conf.Behavior.Resources.Request.Memory == "" {
+		conf.Behavior.Resources.Request.Memory = parent.Behavior.Resources.Request.Memory
+	}
+	if conf.Behavior.Resources.Limit.CPU == "" {
+		conf.Behavior.Resources.Limit.CPU = parent.Behavior.Resources.Limit.CPU
+	}
+	if conf.Behavior.Resources.Limit.Memory == "" {
+		conf.Behavior.Resources.Limit.Memory = parent.Behavior.Resources.Limit.Memory
+	}
+	if conf.Behavior.Execution.Pool == "" {
+		conf.Behavior.Execution.Pool = parent.Behavior.Execution.Pool
+	}
+	if conf.Behavior.Execution.Queue == "" {
+		conf.Behavior.Execution.Queue = parent.Behavior.Execution.Queue
+	}
+	if conf.Behavior.Execution.Timeout == "" {
+		conf.Behavior.Execution.Timeout = parent.Behavior.Execution.Timeout
+	}
 	for _, pNotify := range parent.Behavior.Notify {
 		childNotifyIdx := -1
 		for cnIdx, cn := range conf.Behavior.Notify {
@@ -410,6 +449,14 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 		}
 	}
 
+	executionTimeout := time.Duration(0)
+	if conf.Behavior.Execution.Timeout != "" {
+		executionTimeout, err = time.ParseDuration(conf.Behavior.Execution.Timeout)
+		if err != nil {
+			return models.JobSpec{}, errors.Wrapf(err, "failed to parse execution timeout %s", conf.Behavior.Execution.Timeout)
+		}
+	}
+
 	var jobNotifiers []models.JobSpecNotifier
 	for _, notify := range conf.Behavior.Notify {
 		jobNotifiers = append(jobNotifiers, models.JobSpecNotifier{
@@ -439,6 +486,21 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 				ExponentialBackoff: conf.Behavior.Retry.ExponentialBackoff,
 			},
 			Notify: jobNotifiers,
+			Resources: models.JobSpecResources{
+				Request: models.JobSpecResourceConfig{
+					CPU:    conf.Behavior.Resources.Request.CPU,
+					Memory: conf.Behavior.Resources.Request.Memory,
+				},
+				Limit: models.JobSpecResourceConfig{
+					CPU:    conf.Behavior.Resources.Limit.CPU,
+					Memory: conf.Behavior.Resources.Limit.Memory,
+				},
+			},
+			Execution: models.JobSpecExecution{
+				Pool:    conf.Behavior.Execution.Pool,
+				Queue:   conf.Behavior.Execution.Queue,
+				Timeout: executionTimeout,
+			},
 		},
 		Task: models.JobSpecTask{
 			Unit:   execUnit,
@@ -475,6 +537,11 @@ func (adapt JobSpecAdapter) FromSpec(spec models.JobSpec) (Job, error) {
 		retryDelayDuration = spec.Behavior.Retry.Delay.String()
 	}
 
+	executionTimeout := ""
+	if spec.Behavior.Execution.Timeout.Nanoseconds() > 0 {
+		executionTimeout = spec.Behavior.Execution.Timeout.String()
+	}
+
 	var notifiers []JobNotifier
 	for _, notify := range spec.Behavior.Notify {
 		notifiers = append(notifiers, JobNotifier{
@@ -503,6 +570,21 @@ func (adapt JobSpecAdapter) FromSpec(spec models.JobSpec) (Job, error) {
 				ExponentialBackoff: spec.Behavior.Retry.ExponentialBackoff,
 			},
 			Notify: notifiers,
+			Resources: JobResources{
+				Request: JobResourceConfig{
+					CPU:    spec.Behavior.Resources.Request.CPU,
+					Memory: spec.Behavior.Resources.Request.Memory,
+				},
+				Limit: JobResourceConfig{
+					CPU:    spec.Behavior.Resources.Limit.CPU,
+					Memory: spec.Behavior.Resources.Limit.Memory,
+				},
+			},
+			Execution: JobExecution{
+				Pool:    spec.Behavior.Execution.Pool,
+				Queue:   spec.Behavior.Execution.Queue,
+				Timeout: executionTimeout,
+			},
 		},
 		Task: JobTask{
 			Name:   spec.Task.Unit.Info().Name,
@@ -303,6 +303,61 @@ func TestJob_MergeFrom(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should inherit resources and execution configs from parent if doesn't exists",
+			fields: fields{
+				child: local.Job{
+					Behavior: local.JobBehavior{
+						Resources: local.JobResources{
+							Request: local.JobResourceConfig{
+								CPU: "500m",
+							},
+						},
+						Execution: local.JobExecution{
+							Queue: "heavy",
+						},
+					},
+				},
+				expected: local.Job{
+					Behavior: local.JobBehavior{
+						Resources: local.JobResources{
+							Request: local.JobResourceConfig{
+								CPU:    "500m",
+								Memory: "128Mi",
+							},
+							Limit: local.JobResourceConfig{
+								Memory: "1Gi",
+							},
+						},
+						Execution: local.JobExecution{
+							Pool:    "bigquery",
+							Queue:   "heavy",
+							Timeout: "2h",
+						},
+					},
+				},
+			},
+			args: args{
+				parent: local.Job{
+					Behavior: local.JobBehavior{
+						Resources: local.JobResources{
+							Request: local.JobResourceConfig{
+								CPU:    "250m",
+								Memory: "128Mi",
+							},
+							Limit: local.JobResourceConfig{
+								Memory: "1Gi",
+							},
+						},
+						Execution: local.JobExecution{
+							Pool:    "bigquery",
+							Queue:   "default",
+							Timeout: "2h",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "should inherit notify configs from parent if doesn't exists",
 			fields: fields{
@@ -369,6 +424,8 @@ func TestJob_MergeFrom(t *testing.T) {
 			assert.Equal(t, tt.fields.expected.Behavior.Retry.Count, tt.fields.child.Behavior.Retry.Count)
 			assert.Equal(t, tt.fields.expected.Behavior.Retry.Delay, tt.fields.child.Behavior.Retry.Delay)
 			assert.Equal(t, tt.fields.expected.Behavior.Retry.ExponentialBackoff, tt.fields.child.Behavior.Retry.ExponentialBackoff)
+			assert.Equal(t, tt.fields.expected.Behavior.Resources, tt.fields.child.Behavior.Resources)
+			assert.Equal(t, tt.fields.expected.Behavior.Execution, tt.fields.child.Behavior.Execution)
 			assert.ElementsMatch(t, tt.fields.expected.Dependencies, tt.fields.child.Dependencies)
 			assert.Equal(t, len(tt.fields.expected.Labels), len(tt.fields.child.Labels))
 			assert.Equal(t, tt.fields.expected.Task.Name, tt.fields.child.Task.Name)
@@ -26,6 +26,7 @@ type Instance struct {
 	ExecutedAt *time.Time
 	Status     string
 	Data       datatypes.JSON
+	Attempt    int
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -51,6 +52,7 @@ func (j Instance) ToSpec() (models.InstanceSpec, error) {
 		ExecutedAt: execAt,
 		Status:     models.JobRunState(j.Status),
 		Data:       data,
+		Attempt:    j.Attempt,
 		UpdatedAt:  j.UpdatedAt,
 	}, nil
 }
@@ -65,6 +67,11 @@ func (j Instance) FromSpec(spec models.InstanceSpec, jobRunID uuid.UUID) (Instan
 	if !spec.ExecutedAt.IsZero() {
 		execAt = &spec.ExecutedAt
 	}
+	attempt := spec.Attempt
+	if attempt < 1 {
+		// instances which are not retried are always the first attempt
+		attempt = 1
+	}
 	return Instance{
 		ID:         spec.ID,
 		JobRunID:   jobRunID,
@@ -73,6 +80,7 @@ func (j Instance) FromSpec(spec models.InstanceSpec, jobRunID uuid.UUID) (Instan
 		ExecutedAt: execAt,
 		Status:     spec.Status.String(),
 		Data:       dataJSON,
+		Attempt:    attempt,
 	}, nil
 }
 
@@ -92,17 +100,18 @@ func (repo *InstanceRepository) Insert(ctx context.Context, run models.JobRun, s
 }
 
 func (repo *InstanceRepository) Save(ctx context.Context, run models.JobRun, spec models.InstanceSpec) error {
+	resource, err := Instance{}.FromSpec(spec, run.ID)
+	if err != nil {
+		return err
+	}
+
 	existingResource, err := repo.GetByName(ctx, run.ID, spec.Name, spec.Type.String())
-	if errors.Is(err, store.ErrResourceNotFound) {
+	if errors.Is(err, store.ErrResourceNotFound) || (err == nil && existingResource.Attempt != resource.Attempt) {
+		// every attempt is stored separately
 		return repo.Insert(ctx, run, spec)
 	} else if err != nil {
 		return errors.Wrap(err, "unable to find instance by schedule")
 	}
-
-	resource, err := Instance{}.FromSpec(spec, run.ID)
-	if err != nil {
-		return err
-	}
 	resource.ID = existingResource.ID
 	return repo.db.WithContext(ctx).Debug().Omit("JobRun").Model(&resource).Updates(&resource).Error
 }
@@ -116,9 +125,11 @@ func (repo *InstanceRepository) UpdateStatus(ctx context.Context, id uuid.UUID,
 	return repo.db.WithContext(ctx).Omit("JobRun").Save(&r).Error
 }
 
+// GetByName returns the latest attempt of an instance in the job run
 func (repo *InstanceRepository) GetByName(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) (models.InstanceSpec, error) {
 	var r Instance
-	if err := repo.db.WithContext(ctx).Preload("JobRun").Where("job_run_id = ? AND instance_name = ? AND instance_type = ?", runID, instanceName, instanceType).First(&r).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Preload("JobRun").Where("job_run_id = ? AND instance_name = ? AND instance_type = ?", runID, instanceName, instanceType).
+		Order("attempt desc").First(&r).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.InstanceSpec{}, store.ErrResourceNotFound
 		}
@@ -146,14 +157,35 @@ func (repo *InstanceRepository) DeleteByJobRun(ctx context.Context, runID uuid.U
 	return repo.db.WithContext(ctx).Where("job_run_id = ?", runID).Delete(&Instance{}).Error
 }
 
+// GetByJobRun returns the latest attempt of every instance in the job run
 func (repo *InstanceRepository) GetByJobRun(ctx context.Context, runID uuid.UUID) ([]Instance, error) {
 	var r []Instance
-	if err := repo.db.WithContext(ctx).Where("job_run_id = ?", runID).Find(&r).Error; err != nil {
+	if err := repo.db.WithContext(ctx).Select("DISTINCT ON (instance_name, instance_type) *").Where("job_run_id = ?", runID).
+		Order("instance_name, instance_type, attempt desc").Find(&r).Error; err != nil {
 		return nil, err
 	}
 	return r, nil
 }
 
+// GetAttempts returns all the attempts of an instance in the job run ordered
+// by attempt number
+func (repo *InstanceRepository) GetAttempts(ctx context.Context, runID uuid.UUID, instanceName, instanceType string) ([]models.InstanceSpec, error) {
+	var specs []models.InstanceSpec
+	var r []Instance
+	if err := repo.db.WithContext(ctx).Where("job_run_id = ? AND instance_name = ? AND instance_type = ?", runID, instanceName, instanceType).
+		Order("attempt asc").Find(&r).Error; err != nil {
+		return specs, err
+	}
+	for _, instance := range r {
+		spec, err := instance.ToSpec()
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
 func NewInstanceRepository(db *gorm.DB, jobAdapter *JobSpecAdapter) *InstanceRepository {
 	return &InstanceRepository{
 		db:         db,
@@ -41,6 +41,12 @@ type Job struct {
 	WindowOffset     *int64
 	WindowTruncateTo *string
 
+	// Priority is the weight assigned to job at the time of deployment
+	// based on its position in the dependency tree
+	Priority int
+
+	Paused bool
+
 	Assets datatypes.JSON
 	Hooks  datatypes.JSON
 
@@ -54,6 +60,8 @@ type JobBehavior struct {
 	CatchUp       bool
 	Retry         JobBehaviorRetry
 	Notify        []JobBehaviorNotifier
+	Resources     JobBehaviorResources
+	Execution     JobBehaviorExecution
 }
 
 type JobBehaviorRetry struct {
@@ -62,6 +70,22 @@ type JobBehaviorRetry struct {
 	ExponentialBackoff bool
 }
 
+type JobBehaviorResources struct {
+	Request JobBehaviorResourceConfig
+	Limit   JobBehaviorResourceConfig
+}
+
+type JobBehaviorResourceConfig struct {
+	CPU    string
+	Memory string
+}
+
+type JobBehaviorExecution struct {
+	Pool    string
+	Queue   string
+	Timeout int64
+}
+
 type JobBehaviorNotifier struct {
 	On       string
 	Config   map[string]string
@@ -218,6 +242,21 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 				ExponentialBackoff: behavior.Retry.ExponentialBackoff,
 			},
 			Notify: notifiers,
+			Resources: models.JobSpecResources{
+				Request: models.JobSpecResourceConfig{
+					CPU:    behavior.Resources.Request.CPU,
+					Memory: behavior.Resources.Request.Memory,
+				},
+				Limit: models.JobSpecResourceConfig{
+					CPU:    behavior.Resources.Limit.CPU,
+					Memory: behavior.Resources.Limit.Memory,
+				},
+			},
+			Execution: models.JobSpecExecution{
+				Pool:    behavior.Execution.Pool,
+				Queue:   behavior.Execution.Queue,
+				Timeout: time.Duration(behavior.Execution.Timeout),
+			},
 		},
 		Task: models.JobSpecTask{
 			Unit:   execUnit,
@@ -227,10 +266,12 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 				Offset:     time.Duration(*conf.WindowOffset),
 				TruncateTo: *conf.WindowTruncateTo,
 			},
+			Priority: conf.Priority,
 		},
 		Assets:       *(models.JobAssets{}).New(jobAssets),
 		Dependencies: dependencies,
 		Hooks:        jobHooks,
+		Paused:       conf.Paused,
 	}
 	return job, nil
 }
@@ -264,6 +305,21 @@ func (adapt JobSpecAdapter) FromJobSpec(spec models.JobSpec) (Job, error) {
 			ExponentialBackoff: spec.Behavior.Retry.ExponentialBackoff,
 		},
 		Notify: notifiers,
+		Resources: JobBehaviorResources{
+			Request: JobBehaviorResourceConfig{
+				CPU:    spec.Behavior.Resources.Request.CPU,
+				Memory: spec.Behavior.Resources.Request.Memory,
+			},
+			Limit: JobBehaviorResourceConfig{
+				CPU:    spec.Behavior.Resources.Limit.CPU,
+				Memory: spec.Behavior.Resources.Limit.Memory,
+			},
+		},
+		Execution: JobBehaviorExecution{
+			Pool:    spec.Behavior.Execution.Pool,
+			Queue:   spec.Behavior.Execution.Queue,
+			Timeout: spec.Behavior.Execution.Timeout.Nanoseconds(),
+		},
 	})
 	if err != nil {
 		return Job{}, err
@@ -342,6 +398,8 @@ func (adapt JobSpecAdapter) FromJobSpec(spec models.JobSpec) (Job, error) {
 		WindowSize:       &wsize,
 		WindowOffset:     &woffset,
 		WindowTruncateTo: &spec.Task.Window.TruncateTo,
+		Priority:         spec.Task.Priority,
+		Paused:           spec.Paused,
 		Assets:           assetsJSON,
 		Hooks:            hooksJSON,
 	}, nil
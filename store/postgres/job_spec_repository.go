@@ -186,6 +186,19 @@ func (repo *JobSpecRepository) Delete(ctx context.Context, name string) error {
 	return repo.db.WithContext(ctx).Where("namespace_id = ? AND name = ?", repo.namespace.ID, name).Delete(&Job{}).Error
 }
 
+// SetPaused updates the paused flag explicitly as saving a spec skips
+// the fields with zero values
+func (repo *JobSpecRepository) SetPaused(ctx context.Context, name string, paused bool) error {
+	result := repo.db.WithContext(ctx).Model(&Job{}).Where("namespace_id = ? AND name = ?", repo.namespace.ID, name).Update("paused", paused)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrResourceNotFound
+	}
+	return nil
+}
+
 func (repo *JobSpecRepository) HardDelete(ctx context.Context, name string) error {
 	// find the base job
 	var r Job
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -558,6 +559,42 @@ func TestJobRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(checkModels))
 	})
+
+	t.Run("SetPaused", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		testModels := []models.JobSpec{}
+		testModels = append(testModels, testConfigs...)
+
+		projectJobSpecRepo := NewProjectJobSpecRepository(db, projectSpec, adapter)
+		repo := NewJobSpecRepository(db, namespaceSpec, projectJobSpecRepo, adapter)
+
+		err := repo.Insert(ctx, testModels[0])
+		assert.Nil(t, err)
+
+		err = repo.SetPaused(ctx, testModels[0].Name, true)
+		assert.Nil(t, err)
+		checkModel, err := repo.GetByName(ctx, testModels[0].Name)
+		assert.Nil(t, err)
+		assert.True(t, checkModel.Paused)
+
+		// saving spec again should keep the job paused
+		err = repo.Save(ctx, testModels[0])
+		assert.Nil(t, err)
+		checkModel, err = repo.GetByName(ctx, testModels[0].Name)
+		assert.Nil(t, err)
+		assert.True(t, checkModel.Paused)
+
+		err = repo.SetPaused(ctx, testModels[0].Name, false)
+		assert.Nil(t, err)
+		checkModel, err = repo.GetByName(ctx, testModels[0].Name)
+		assert.Nil(t, err)
+		assert.False(t, checkModel.Paused)
+
+		err = repo.SetPaused(ctx, "unknown", true)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
 }
 
 func TestProjectJobRepository(t *testing.T) {
@@ -74,14 +74,35 @@ func (repo *JobRunRepository) GetByScheduledAt(ctx context.Context, jobID uuid.U
 	return repo.adapter.ToJobRun(r)
 }
 
+// GetPrevious returns the latest run of a job with provided trigger scheduled before provided time
+func (repo *JobRunRepository) GetPrevious(ctx context.Context, jobID uuid.UUID, scheduledAt time.Time,
+	trigger models.JobRunTrigger) (models.JobRun, models.NamespaceSpec, error) {
+	var r JobRun
+	if err := repo.db.WithContext(ctx).Preload("Namespace").Where("job_id = ? AND scheduled_at < ? AND trigger = ?", jobID, scheduledAt, trigger).
+		Order("scheduled_at desc").First(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.JobRun{}, models.NamespaceSpec{}, store.ErrResourceNotFound
+		}
+		return models.JobRun{}, models.NamespaceSpec{}, err
+	}
+	if instances, err := repo.instanceRepo.GetByJobRun(ctx, r.ID); err == nil {
+		r.Instances = instances
+	}
+	return repo.adapter.ToJobRun(r)
+}
+
 // AddInstance associate instance details
 func (repo *JobRunRepository) AddInstance(ctx context.Context, namespaceSpec models.NamespaceSpec, run models.JobRun, spec models.InstanceSpec) error {
 	instance, err := repo.instanceRepo.GetByName(ctx, run.ID, spec.Name, spec.Type.String())
 	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
 		return err
 	}
-	if instance.ID.String() != "" {
-		// delete if associated before
+	attempt := spec.Attempt
+	if attempt < 1 {
+		attempt = 1
+	}
+	if instance.ID.String() != "" && instance.Attempt == attempt {
+		// delete if same attempt is associated before
 		if err := repo.instanceRepo.Delete(ctx, instance.ID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			return err
 		}
@@ -89,6 +110,12 @@ func (repo *JobRunRepository) AddInstance(ctx context.Context, namespaceSpec mod
 	return repo.instanceRepo.Save(ctx, run, spec)
 }
 
+// GetInstanceAttempts returns every attempt made to execute an instance in
+// the job run
+func (repo *JobRunRepository) GetInstanceAttempts(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) ([]models.InstanceSpec, error) {
+	return repo.instanceRepo.GetAttempts(ctx, runID, instanceName, instanceType.String())
+}
+
 // ClearInstance deletes associated instance details
 func (repo *JobRunRepository) ClearInstance(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) error {
 	r, _, err := repo.GetByID(ctx, runID)
@@ -130,6 +157,16 @@ func (repo *JobRunRepository) UpdateStatus(ctx context.Context, id uuid.UUID, st
 	return repo.db.Omit("Namespace").Save(jr).Error
 }
 
+func (repo *JobRunRepository) UpdateStatusFrom(ctx context.Context, runID uuid.UUID, from, to models.JobRunState) (bool, error) {
+	result := repo.db.WithContext(ctx).Model(&JobRun{}).
+		Where("id = ? AND status = ?", runID, from.String()).
+		Update("status", to.String())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (repo *JobRunRepository) GetByStatus(ctx context.Context, statuses ...models.JobRunState) ([]models.JobRun, error) {
 	var specs []models.JobRun
 	var runs []JobRun
@@ -176,6 +213,49 @@ func (repo *JobRunRepository) GetByTrigger(ctx context.Context, trigger models.J
 	return specs, nil
 }
 
+// GetByJob returns all the runs of a job scheduled between provided start and
+// end dates, both inclusive, ordered by their schedule
+func (repo *JobRunRepository) GetByJob(ctx context.Context, jobID uuid.UUID, startDate, endDate time.Time) ([]models.JobRun, error) {
+	var specs []models.JobRun
+	var runs []JobRun
+	if err := repo.db.WithContext(ctx).Where("job_id = ? AND scheduled_at >= ? AND scheduled_at <= ?", jobID, startDate, endDate).
+		Order("scheduled_at asc").Find(&runs).Error; err != nil {
+		return specs, err
+	}
+
+	for _, run := range runs {
+		if instances, err := repo.instanceRepo.GetByJobRun(ctx, run.ID); err == nil {
+			run.Instances = instances
+		}
+		adapt, _, err := repo.adapter.ToJobRun(run)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
+// GetLatestByNamespace returns the most recently scheduled run of every job
+// which has been executed at least once in provided namespace
+func (repo *JobRunRepository) GetLatestByNamespace(ctx context.Context, namespaceID uuid.UUID) ([]models.JobRun, error) {
+	var specs []models.JobRun
+	var runs []JobRun
+	if err := repo.db.WithContext(ctx).Select("DISTINCT ON (job_id) *").Where("namespace_id = ?", namespaceID).
+		Order("job_id, scheduled_at desc").Find(&runs).Error; err != nil {
+		return specs, err
+	}
+
+	for _, run := range runs {
+		adapt, _, err := repo.adapter.ToJobRun(run)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
 func NewJobRunRepository(db *gorm.DB, adapter *JobSpecAdapter) *JobRunRepository {
 	return &JobRunRepository{
 		db:           db,
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -284,6 +285,30 @@ func TestJobRunRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(runs))
 	})
+	t.Run("UpdateStatusFrom", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		var testModels []models.JobRun
+		testModels = append(testModels, testSpecs...)
+
+		repo := NewJobRunRepository(db, adapter)
+		err := repo.Insert(ctx, namespaceSpec, testModels[1])
+		assert.Nil(t, err)
+
+		updated, err := repo.UpdateStatusFrom(ctx, testModels[1].ID, models.RunStatePending, models.RunStateCancelled)
+		assert.Nil(t, err)
+		assert.False(t, updated)
+
+		updated, err = repo.UpdateStatusFrom(ctx, testModels[1].ID, models.RunStateRunning, models.RunStateCancelling)
+		assert.Nil(t, err)
+		assert.True(t, updated)
+
+		jr, _, err := repo.GetByID(ctx, testModels[1].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.RunStateCancelling, jr.Status)
+	})
 	t.Run("AddInstance", func(t *testing.T) {
 		db := DBSetup()
 		sqlDB, _ := db.DB()
@@ -325,4 +350,106 @@ func TestJobRunRepository(t *testing.T) {
 		assert.Equal(t, 0, len(jr.Instances))
 		assert.Equal(t, models.RunStatePending, jr.Status)
 	})
+	t.Run("GetByJob", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		var testModels []models.JobRun
+		testModels = append(testModels, testSpecs...)
+		testModels[1].ScheduledAt = testModels[0].ScheduledAt.Add(time.Hour * 24)
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[1]))
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[0]))
+		assert.Nil(t, repo.AddInstance(ctx, namespaceSpec, testModels[0], testModels[0].Instances[0]))
+
+		runs, err := repo.GetByJob(ctx, jobConfigs[0].ID, testModels[0].ScheduledAt, testModels[1].ScheduledAt)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(runs))
+		assert.Equal(t, testModels[0].ID, runs[0].ID)
+		assert.Equal(t, 1, len(runs[0].Instances))
+		assert.Equal(t, testModels[1].ID, runs[1].ID)
+
+		runs, err = repo.GetByJob(ctx, jobConfigs[0].ID, testModels[1].ScheduledAt, testModels[1].ScheduledAt.Add(time.Hour))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(runs))
+		assert.Equal(t, testModels[1].ID, runs[0].ID)
+	})
+	t.Run("GetLatestByNamespace", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		var testModels []models.JobRun
+		testModels = append(testModels, testSpecs...)
+		testModels[1].ScheduledAt = testModels[0].ScheduledAt.Add(time.Hour * 24)
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[0]))
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[1]))
+
+		runs, err := repo.GetLatestByNamespace(ctx, namespaceSpec.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(runs))
+		assert.Equal(t, testModels[1].ID, runs[0].ID)
+		assert.Equal(t, jobConfigs[0].Name, runs[0].Spec.Name)
+	})
+	t.Run("GetPrevious", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		var testModels []models.JobRun
+		testModels = append(testModels, testSpecs...)
+		testModels[0].Trigger = models.TriggerSchedule
+		testModels[1].ScheduledAt = testModels[0].ScheduledAt.Add(time.Hour * 24)
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[0]))
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[1]))
+
+		// manual run in between is skipped
+		prevRun, _, err := repo.GetPrevious(ctx, jobConfigs[0].ID, testModels[1].ScheduledAt.Add(time.Hour*24), models.TriggerSchedule)
+		assert.Nil(t, err)
+		assert.Equal(t, testModels[0].ID, prevRun.ID)
+
+		_, _, err = repo.GetPrevious(ctx, jobConfigs[0].ID, testModels[0].ScheduledAt, models.TriggerSchedule)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
+	t.Run("GetInstanceAttempts", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		var testModels []models.JobRun
+		testModels = append(testModels, testSpecs...)
+
+		repo := NewJobRunRepository(db, adapter)
+		assert.Nil(t, repo.Insert(ctx, namespaceSpec, testModels[0]))
+
+		firstAttempt := testInstanceSpecs[0]
+		firstAttempt.Status = models.RunStateFailed
+		firstAttempt.Attempt = 1
+		secondAttempt := testInstanceSpecs[0]
+		secondAttempt.ID = uuid.Must(uuid.NewRandom())
+		secondAttempt.Status = models.RunStateRunning
+		secondAttempt.Attempt = 2
+		assert.Nil(t, repo.AddInstance(ctx, namespaceSpec, testModels[0], firstAttempt))
+		assert.Nil(t, repo.AddInstance(ctx, namespaceSpec, testModels[0], secondAttempt))
+
+		attempts, err := repo.GetInstanceAttempts(ctx, testModels[0].ID, firstAttempt.Type, firstAttempt.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(attempts))
+		assert.Equal(t, models.RunStateFailed, attempts[0].Status)
+		assert.Equal(t, 1, attempts[0].Attempt)
+		assert.Equal(t, models.RunStateRunning, attempts[1].Status)
+		assert.Equal(t, 2, attempts[1].Attempt)
+
+		// job run only holds the latest attempt
+		jr, _, err := repo.GetByID(ctx, testModels[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(jr.Instances))
+		assert.Equal(t, secondAttempt.ID, jr.Instances[0].ID)
+	})
 }
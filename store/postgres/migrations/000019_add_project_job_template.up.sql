@@ -0,0 +1 @@
+ALTER TABLE project ADD COLUMN IF NOT EXISTS job_template TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE replay DROP COLUMN IF EXISTS progress;
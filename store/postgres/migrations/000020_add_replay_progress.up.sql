@@ -0,0 +1 @@
+ALTER TABLE replay ADD COLUMN IF NOT EXISTS progress JSONB;
@@ -18,6 +18,9 @@ type Project struct {
 	Name   string    `gorm:"not null;unique"`
 	Config datatypes.JSON
 
+	// JobTemplate is not updated by saving the project
+	JobTemplate string `gorm:"not null;default:''"`
+
 	// Secrets are read only and will not be saved by updating it here
 	Secrets []Secret
 
@@ -32,9 +35,10 @@ func (p Project) FromSpec(spec models.ProjectSpec) (Project, error) {
 		return Project{}, nil
 	}
 	return Project{
-		ID:     spec.ID,
-		Name:   spec.Name,
-		Config: jsonBytes,
+		ID:          spec.ID,
+		Name:        spec.Name,
+		Config:      jsonBytes,
+		JobTemplate: string(spec.JobTemplate),
 	}, nil
 }
 
@@ -44,9 +48,10 @@ func (p Project) ToSpec() (models.ProjectSpec, error) {
 		return models.ProjectSpec{}, nil
 	}
 	return models.ProjectSpec{
-		ID:     p.ID,
-		Name:   p.Name,
-		Config: conf,
+		ID:          p.ID,
+		Name:        p.Name,
+		Config:      conf,
+		JobTemplate: p.jobTemplate(),
 	}, nil
 }
 
@@ -64,13 +69,21 @@ func (p Project) ToSpecWithSecrets(h models.ApplicationKey) (models.ProjectSpec,
 		specSecrets = append(specSecrets, specSecret)
 	}
 	return models.ProjectSpec{
-		ID:     p.ID,
-		Name:   p.Name,
-		Config: conf,
-		Secret: specSecrets,
+		ID:          p.ID,
+		Name:        p.Name,
+		Config:      conf,
+		Secret:      specSecrets,
+		JobTemplate: p.jobTemplate(),
 	}, nil
 }
 
+func (p Project) jobTemplate() []byte {
+	if p.JobTemplate == "" {
+		return nil
+	}
+	return []byte(p.JobTemplate)
+}
+
 type ProjectRepository struct {
 	db   *gorm.DB
 	hash models.ApplicationKey
@@ -102,6 +115,19 @@ func (repo *ProjectRepository) Save(ctx context.Context, spec models.ProjectSpec
 	return repo.db.WithContext(ctx).Omit("Secrets").Model(&project).Update("Config", project.Config).Error
 }
 
+// SetJobTemplate updates the template explicitly as saving a project only
+// updates its config
+func (repo *ProjectRepository) SetJobTemplate(ctx context.Context, name string, template []byte) error {
+	result := repo.db.WithContext(ctx).Model(&Project{}).Where("name = ?", name).Update("job_template", string(template))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrResourceNotFound
+	}
+	return nil
+}
+
 func (repo *ProjectRepository) GetByName(ctx context.Context, name string) (models.ProjectSpec, error) {
 	var r Project
 	if err := repo.db.WithContext(ctx).Preload("Secrets").Where("name = ?", name).First(&r).Error; err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -183,6 +184,39 @@ func TestProjectRepository(t *testing.T) {
 		sec, _ := checkModel.Secret.GetByName("t1")
 		assert.Equal(t, "v1", sec)
 	})
+	t.Run("SetJobTemplate", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		testModels := []models.ProjectSpec{}
+		testModels = append(testModels, testConfigs...)
+
+		repo := NewProjectRepository(db, hash)
+
+		err := repo.Insert(ctx, testModels[2])
+		assert.Nil(t, err)
+
+		err = repo.SetJobTemplate(ctx, testModels[2].Name, []byte("custom template"))
+		assert.Nil(t, err)
+
+		// saving project again should keep the template
+		err = repo.Save(ctx, testModels[2])
+		assert.Nil(t, err)
+
+		checkModel, err := repo.GetByName(ctx, testModels[2].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("custom template"), checkModel.JobTemplate)
+
+		err = repo.SetJobTemplate(ctx, testModels[2].Name, nil)
+		assert.Nil(t, err)
+
+		checkModel, err = repo.GetByName(ctx, testModels[2].Name)
+		assert.Nil(t, err)
+		assert.Nil(t, checkModel.JobTemplate)
+
+		err = repo.SetJobTemplate(ctx, "unknown-project", []byte("custom template"))
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
 	t.Run("GetAll", func(t *testing.T) {
 		db := DBSetup()
 		sqlDB, _ := db.DB()
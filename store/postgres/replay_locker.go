@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// replayLockClassID namespaces advisory locks of replays from other
+// advisory locks taken on the same database
+const replayLockClassID = 5001
+
+// replayLocker guards replays using session level advisory locks. Each lock
+// keeps its own connection till released, postgres releases the lock if the
+// server holding it dies
+type replayLocker struct {
+	db *sql.DB
+
+	mu    sync.Mutex
+	conns map[uuid.UUID]*sql.Conn
+}
+
+func (l *replayLocker) TryLock(ctx context.Context, replayID uuid.UUID) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, $2)", replayLockClassID, replayLockKey(replayID)).
+		Scan(&locked); err != nil {
+		conn.Close()
+		return false, err
+	}
+	if !locked {
+		conn.Close()
+		return false, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.conns[replayID] = conn
+	return true, nil
+}
+
+func (l *replayLocker) Unlock(ctx context.Context, replayID uuid.UUID) error {
+	l.mu.Lock()
+	conn, ok := l.conns[replayID]
+	delete(l.conns, replayID)
+	l.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	defer conn.Close()
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1, $2)", replayLockClassID, replayLockKey(replayID))
+	return err
+}
+
+func replayLockKey(replayID uuid.UUID) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write(replayID[:])
+	return int32(h.Sum32())
+}
+
+func NewReplayLocker(db *gorm.DB) (*replayLocker, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	return &replayLocker{
+		db:    sqlDB,
+		conns: make(map[uuid.UUID]*sql.Conn),
+	}, nil
+}
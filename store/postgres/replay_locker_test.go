@@ -0,0 +1,65 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestReplayLocker(t *testing.T) {
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 4, 4, os.Stdout)
+		if err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+	ctx := context.Background()
+
+	t.Run("should not acquire lock held by another locker till released", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		replayID := uuid.Must(uuid.NewRandom())
+
+		locker, err := NewReplayLocker(db)
+		assert.Nil(t, err)
+		otherLocker, err := NewReplayLocker(db)
+		assert.Nil(t, err)
+
+		locked, err := locker.TryLock(ctx, replayID)
+		assert.Nil(t, err)
+		assert.True(t, locked)
+
+		locked, err = otherLocker.TryLock(ctx, replayID)
+		assert.Nil(t, err)
+		assert.False(t, locked)
+
+		err = locker.Unlock(ctx, replayID)
+		assert.Nil(t, err)
+
+		locked, err = otherLocker.TryLock(ctx, replayID)
+		assert.Nil(t, err)
+		assert.True(t, locked)
+		assert.Nil(t, otherLocker.Unlock(ctx, replayID))
+	})
+	t.Run("should ignore unlocking replay which is not locked", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+
+		locker, err := NewReplayLocker(db)
+		assert.Nil(t, err)
+		assert.Nil(t, locker.Unlock(ctx, uuid.Must(uuid.NewRandom())))
+	})
+}
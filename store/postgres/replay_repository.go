@@ -27,6 +27,7 @@ type Replay struct {
 	Status        string    `gorm:"not null"`
 	Message       datatypes.JSON
 	ExecutionTree datatypes.JSON
+	Progress      datatypes.JSON
 
 	CreatedAt time.Time `gorm:"not null" json:"created_at"`
 	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
@@ -75,6 +76,11 @@ func (p Replay) FromSpec(spec *models.ReplaySpec) (Replay, error) {
 		return Replay{}, nil
 	}
 
+	progress, err := json.Marshal(spec.Progress)
+	if err != nil {
+		return Replay{}, err
+	}
+
 	var executionTree []byte
 	if spec.ExecutionTree != nil {
 		executionTree, err = json.Marshal(fromTreeNode(spec.ExecutionTree))
@@ -91,6 +97,7 @@ func (p Replay) FromSpec(spec *models.ReplaySpec) (Replay, error) {
 		Status:        spec.Status,
 		Message:       message,
 		ExecutionTree: executionTree,
+		Progress:      progress,
 	}, nil
 }
 
@@ -123,6 +130,13 @@ func (p Replay) ToSpec(jobSpec models.JobSpec) (models.ReplaySpec, error) {
 		return models.ReplaySpec{}, nil
 	}
 
+	progress := models.ReplayProgress{}
+	if p.Progress != nil {
+		if err := json.Unmarshal(p.Progress, &progress); err != nil {
+			return models.ReplaySpec{}, err
+		}
+	}
+
 	var treeNode *tree.TreeNode
 	if p.ExecutionTree != nil {
 		jobTree := ExecutionTree{}
@@ -140,6 +154,7 @@ func (p Replay) ToSpec(jobSpec models.JobSpec) (models.ReplaySpec, error) {
 		EndDate:       p.EndDate,
 		Message:       message,
 		ExecutionTree: treeNode,
+		Progress:      progress,
 		CreatedAt:     p.CreatedAt,
 	}, nil
 }
@@ -193,6 +208,38 @@ func (repo *replayRepository) UpdateStatus(ctx context.Context, replayID uuid.UU
 	return repo.DB.WithContext(ctx).Save(&r).Error
 }
 
+func (repo *replayRepository) UpdateStatusFrom(ctx context.Context, replayID uuid.UUID, from []string, status string,
+	message models.ReplayMessage) (bool, error) {
+	jsonBytes, err := json.Marshal(message)
+	if err != nil {
+		return false, err
+	}
+	result := repo.DB.WithContext(ctx).Model(&Replay{}).Where("id = ? AND status in (?)", replayID, from).
+		Updates(map[string]interface{}{
+			"status":  status,
+			"message": datatypes.JSON(jsonBytes),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *replayRepository) UpdateProgress(ctx context.Context, replayID uuid.UUID, progress models.ReplayProgress) error {
+	jsonBytes, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	result := repo.DB.WithContext(ctx).Model(&Replay{}).Where("id = ?", replayID).Update("progress", datatypes.JSON(jsonBytes))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrResourceNotFound
+	}
+	return nil
+}
+
 func (repo *replayRepository) GetByStatus(ctx context.Context, status []string) ([]models.ReplaySpec, error) {
 	var replays []Replay
 	if err := repo.DB.WithContext(ctx).Where("status in (?)", status).Preload("Job").Find(&replays).Error; err != nil {
@@ -240,6 +240,101 @@ func TestReplayRepository(t *testing.T) {
 		assert.Equal(t, errMessage, checkModel.Message.Message)
 	})
 
+	t.Run("UpdateStatusFrom", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		var testModels []*models.ReplaySpec
+		testModels = append(testModels, testConfigs...)
+
+		execUnit1 := new(mock.BasePlugin)
+		defer execUnit1.AssertExpectations(t)
+		execUnit1.On("PluginInfo").Return(&models.PluginInfoResponse{
+			Name: gTask,
+		}, nil)
+		depMod1 := new(mock.DependencyResolverMod)
+		defer depMod1.AssertExpectations(t)
+
+		pluginRepo := new(mock.SupportedPluginRepo)
+		defer pluginRepo.AssertExpectations(t)
+		pluginRepo.On("GetByName", gTask).Return(&models.Plugin{Base: execUnit1, DependencyMod: depMod1}, nil)
+		adapter := NewAdapter(pluginRepo)
+
+		jobConfigs[0].Task = models.JobSpecTask{Unit: &models.Plugin{Base: execUnit1}}
+		testConfigs[0].Job = jobConfigs[0]
+
+		projectJobSpecRepo := NewProjectJobSpecRepository(db, projectSpec, adapter)
+		jobRepo := NewJobSpecRepository(db, namespaceSpec, projectJobSpecRepo, adapter)
+		err := jobRepo.Insert(ctx, jobConfigs[0])
+		assert.Nil(t, err)
+
+		repo := NewReplayRepository(db, adapter)
+		err = repo.Insert(ctx, testModels[0])
+		assert.Nil(t, err)
+
+		updated, err := repo.UpdateStatusFrom(ctx, testModels[0].ID, []string{models.ReplayStatusInProgress},
+			models.ReplayStatusReplayed, models.ReplayMessage{})
+		assert.Nil(t, err)
+		assert.False(t, updated)
+
+		updated, err = repo.UpdateStatusFrom(ctx, testModels[0].ID, []string{models.ReplayStatusAccepted, models.ReplayStatusInProgress},
+			models.ReplayStatusCancelled, models.ReplayMessage{Type: models.ReplayStatusCancelled})
+		assert.Nil(t, err)
+		assert.True(t, updated)
+
+		checkModel, err := repo.GetByID(ctx, testModels[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, models.ReplayStatusCancelled, checkModel.Status)
+		assert.Equal(t, models.ReplayStatusCancelled, checkModel.Message.Type)
+	})
+
+	t.Run("UpdateProgress", func(t *testing.T) {
+		db := DBSetup()
+		sqlDB, _ := db.DB()
+		defer sqlDB.Close()
+		var testModels []*models.ReplaySpec
+		testModels = append(testModels, testConfigs...)
+
+		execUnit1 := new(mock.BasePlugin)
+		defer execUnit1.AssertExpectations(t)
+		execUnit1.On("PluginInfo").Return(&models.PluginInfoResponse{
+			Name: gTask,
+		}, nil)
+		depMod1 := new(mock.DependencyResolverMod)
+		defer depMod1.AssertExpectations(t)
+
+		pluginRepo := new(mock.SupportedPluginRepo)
+		defer pluginRepo.AssertExpectations(t)
+		pluginRepo.On("GetByName", gTask).Return(&models.Plugin{Base: execUnit1, DependencyMod: depMod1}, nil)
+		adapter := NewAdapter(pluginRepo)
+
+		jobConfigs[0].Task = models.JobSpecTask{Unit: &models.Plugin{Base: execUnit1}}
+		testConfigs[0].Job = jobConfigs[0]
+
+		projectJobSpecRepo := NewProjectJobSpecRepository(db, projectSpec, adapter)
+		jobRepo := NewJobSpecRepository(db, namespaceSpec, projectJobSpecRepo, adapter)
+		err := jobRepo.Insert(ctx, jobConfigs[0])
+		assert.Nil(t, err)
+
+		repo := NewReplayRepository(db, adapter)
+		err = repo.Insert(ctx, testModels[0])
+		assert.Nil(t, err)
+
+		progress := models.ReplayProgress{
+			ClearedBatches: 1,
+			TotalBatches:   3,
+		}
+		err = repo.UpdateProgress(ctx, testModels[0].ID, progress)
+		assert.Nil(t, err)
+
+		checkModel, err := repo.GetByID(ctx, testModels[0].ID)
+		assert.Nil(t, err)
+		assert.Equal(t, progress, checkModel.Progress)
+
+		err = repo.UpdateProgress(ctx, uuid.Must(uuid.NewRandom()), progress)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
+
 	t.Run("GetByStatus", func(t *testing.T) {
 		t.Run("should return list of job specs given list of status", func(t *testing.T) {
 			db := DBSetup()
@@ -27,6 +27,10 @@ type ProjectRepository interface {
 	Save(context.Context, models.ProjectSpec) error
 	GetByName(context.Context, string) (models.ProjectSpec, error)
 	GetAll(context.Context) ([]models.ProjectSpec, error)
+
+	// SetJobTemplate updates only the job template of the project, an empty
+	// template resets it to the default of batch scheduler
+	SetJobTemplate(ctx context.Context, name string, template []byte) error
 }
 
 // ProjectSecretRepository stores secrets attached to projects
@@ -51,10 +55,21 @@ type JobRunRepository interface {
 	Save(context.Context, models.NamespaceSpec, models.JobRun) error
 
 	GetByScheduledAt(ctx context.Context, jobID uuid.UUID, scheduledAt time.Time) (models.JobRun, models.NamespaceSpec, error)
+
+	// GetPrevious returns the latest run of a job with provided trigger scheduled before provided time
+	GetPrevious(ctx context.Context, jobID uuid.UUID, scheduledAt time.Time, trigger models.JobRunTrigger) (models.JobRun, models.NamespaceSpec, error)
 	GetByID(context.Context, uuid.UUID) (models.JobRun, models.NamespaceSpec, error)
 	UpdateStatus(context.Context, uuid.UUID, models.JobRunState) error
+
+	// UpdateStatusFrom updates status of the run only if it is still in the
+	// from state, reports if the run got updated
+	UpdateStatusFrom(ctx context.Context, runID uuid.UUID, from, to models.JobRunState) (bool, error)
 	GetByStatus(ctx context.Context, state ...models.JobRunState) ([]models.JobRun, error)
 	GetByTrigger(ctx context.Context, trigger models.JobRunTrigger, state ...models.JobRunState) ([]models.JobRun, error)
+	GetByJob(ctx context.Context, jobID uuid.UUID, startDate, endDate time.Time) ([]models.JobRun, error)
+
+	// GetLatestByNamespace returns last run of each job executed in a namespace
+	GetLatestByNamespace(ctx context.Context, namespaceID uuid.UUID) ([]models.JobRun, error)
 	Delete(context.Context, uuid.UUID) error
 
 	AddInstance(ctx context.Context, namespace models.NamespaceSpec, run models.JobRun, spec models.InstanceSpec) error
@@ -63,6 +78,10 @@ type JobRunRepository interface {
 	// for fresh start
 	Clear(ctx context.Context, runID uuid.UUID) error
 	ClearInstance(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) error
+
+	// GetInstanceAttempts returns all the attempts of an instance ordered by
+	// attempt number, job run only holds the latest attempt of each instance
+	GetInstanceAttempts(ctx context.Context, runID uuid.UUID, instanceType models.InstanceType, instanceName string) ([]models.InstanceSpec, error)
 }
 
 // JobRunSpecRepository represents a storage interface for Job run instances created
@@ -75,6 +94,13 @@ type InstanceRepository interface {
 	DeleteByJobRun(ctx context.Context, id uuid.UUID) error
 }
 
+// InstanceLogRepository represents a storage interface for output of job run
+// instances
+type InstanceLogRepository interface {
+	Save(ctx context.Context, instanceID uuid.UUID, logs []byte) error
+	GetByInstanceID(ctx context.Context, instanceID uuid.UUID) ([]byte, error)
+}
+
 // ProjectResourceSpecRepository represents a storage interface for Resource specifications at project level
 type ProjectResourceSpecRepository interface {
 	GetByName(context.Context, string) (models.ResourceSpec, models.NamespaceSpec, error)
@@ -95,6 +121,11 @@ type ReplaySpecRepository interface {
 	Insert(ctx context.Context, replay *models.ReplaySpec) error
 	GetByID(ctx context.Context, id uuid.UUID) (models.ReplaySpec, error)
 	UpdateStatus(ctx context.Context, replayID uuid.UUID, status string, message models.ReplayMessage) error
+
+	// UpdateStatusFrom updates status of the replay only if it is still in
+	// one of the from statuses, reports if the replay got updated
+	UpdateStatusFrom(ctx context.Context, replayID uuid.UUID, from []string, status string, message models.ReplayMessage) (bool, error)
+	UpdateProgress(ctx context.Context, replayID uuid.UUID, progress models.ReplayProgress) error
 	GetByStatus(ctx context.Context, status []string) ([]models.ReplaySpec, error)
 	GetByJobIDAndStatus(ctx context.Context, jobID uuid.UUID, status []string) ([]models.ReplaySpec, error)
 	GetByProjectIDAndStatus(ctx context.Context, projectID uuid.UUID, status []string) ([]models.ReplaySpec, error)